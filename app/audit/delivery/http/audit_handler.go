@@ -0,0 +1,86 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/iamaul/fatbellies/app/audit"
+	"github.com/iamaul/fatbellies/app/models"
+	"github.com/iamaul/fatbellies/utils"
+	"github.com/labstack/echo/v4"
+)
+
+type AuditHandler struct {
+	Auditcase audit.Usecase
+}
+
+func NewAuditHandler(e *echo.Echo, au audit.Usecase) {
+	handler := &AuditHandler{
+		Auditcase: au,
+	}
+
+	v1 := e.Group("/api/v1")
+	v1.GET("/audit", handler.Fetch)
+
+	// Deprecated alias of the v1 route, served until utils.LegacySunset
+	g := e.Group("/api")
+	g.GET("/audit", handler.Fetch, utils.Deprecated("/api/v1/audit"))
+}
+
+// @Summary List audit entries
+// @Description Get the audit log of administrative changes, newest first
+// @Tags Audit
+// @Accept  json
+// @Produce  json
+// @Param entity query string false "branch, branch_location, meal_plan or branch_meal_plan"
+// @Param entity_id query string false "Entity ID"
+// @Param actor query string false "Actor"
+// @Param from query string false "RFC3339 start time (inclusive)"
+// @Param to query string false "RFC3339 end time (exclusive)"
+// @Param limit query integer 50 "limit numbers"
+// @Param page query integer 1 "pagination"
+// @Success 200 {array} models.AuditEntry
+// @Router /audit [get]
+func (ah *AuditHandler) Fetch(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	queryLimit := c.QueryParam("limit")
+	limit, _ := strconv.Atoi(queryLimit)
+	queryPage := c.QueryParam("page")
+	page, _ := strconv.Atoi(queryPage)
+
+	filter := models.AuditFilter{
+		Entity:   c.QueryParam("entity"),
+		EntityID: c.QueryParam("entity_id"),
+		Actor:    c.QueryParam("actor"),
+		Limit:    int64(limit),
+		Page:     int64(page),
+	}
+
+	var err error
+
+	if from := c.QueryParam("from"); from != "" {
+		if filter.From, err = time.Parse(time.RFC3339, from); err != nil {
+			return utils.InvalidField("from", "datetime", "from must be an RFC3339 time")
+		}
+	}
+
+	if to := c.QueryParam("to"); to != "" {
+		if filter.To, err = time.Parse(time.RFC3339, to); err != nil {
+			return utils.InvalidField("to", "datetime", "to must be an RFC3339 time")
+		}
+	}
+
+	res, err := ah.Auditcase.Fetch(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &utils.ResponseJSON{
+		Code:    http.StatusOK,
+		Result:  res,
+		Message: "Fetched data successfully",
+		Success: true,
+	})
+}
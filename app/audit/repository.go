@@ -0,0 +1,13 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/iamaul/fatbellies/app/models"
+)
+
+// Repository represent the audit log's repository contract
+type Repository interface {
+	Fetch(ctx context.Context, filter models.AuditFilter) (*[]models.AuditEntry, error)
+	Store(ctx context.Context, entries []models.AuditEntry) error
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"github.com/iamaul/fatbellies/app/audit"
+	"github.com/iamaul/fatbellies/app/models"
+	"github.com/iamaul/fatbellies/config/database"
+	"github.com/jinzhu/gorm"
+)
+
+type auditRepository struct {
+	Db *gorm.DB
+}
+
+func NewAuditRepository(connection *gorm.DB) audit.Repository {
+	return &auditRepository{connection}
+}
+
+func (ar *auditRepository) conn(ctx context.Context) *gorm.DB {
+	return database.WithContext(ctx, ar.Db)
+}
+
+func (ar *auditRepository) Fetch(ctx context.Context, filter models.AuditFilter) (res *[]models.AuditEntry, err error) {
+	entries := &[]models.AuditEntry{}
+
+	db := ar.conn(ctx).Model(&models.AuditEntry{})
+
+	if filter.Entity != "" {
+		db = db.Where("entity = ?", filter.Entity)
+	}
+	if filter.EntityID != "" {
+		db = db.Where("entity_id = ?", filter.EntityID)
+	}
+	if filter.Actor != "" {
+		db = db.Where("actor = ?", filter.Actor)
+	}
+	if !filter.From.IsZero() {
+		db = db.Where("created_at >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		db = db.Where("created_at < ?", filter.To)
+	}
+
+	if err = db.Limit(filter.Limit).Offset(filter.Limit * (filter.Page - 1)).Order("created_at desc").Find(entries).Error; err != nil {
+		return
+	}
+
+	res = entries
+
+	return
+}
+
+// Store writes the entries of one event. Entries already recorded for the
+// event are skipped, since events may be relayed more than once.
+func (ar *auditRepository) Store(ctx context.Context, entries []models.AuditEntry) (err error) {
+	err = ar.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		for i := range entries {
+			if err := tx.Set("gorm:insert_option", "ON CONFLICT (event_id, entity, entity_id) DO NOTHING").Create(&entries[i]).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	return
+}
@@ -0,0 +1,13 @@
+package audit
+
+import (
+	"context"
+	"github.com/iamaul/fatbellies/app/events"
+	"github.com/iamaul/fatbellies/app/models"
+)
+
+// Usecase represent the audit log's usecases
+type Usecase interface {
+	Fetch(ctx context.Context, filter models.AuditFilter) (*[]models.AuditEntry, error)
+	HandleEvent(env events.Envelope) error
+}
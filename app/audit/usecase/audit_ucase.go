@@ -0,0 +1,202 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iamaul/fatbellies/app/audit"
+	"github.com/iamaul/fatbellies/app/events"
+	"github.com/iamaul/fatbellies/app/models"
+)
+
+// Fields that are either associations audited on their own or bookkeeping
+var ignoredFields = map[string]bool{
+	"locations":         true,
+	"branch_meal_plans": true,
+	"updated_at":        true,
+	"distance":          true,
+}
+
+type auditUsecase struct {
+	auditRepo      audit.Repository
+	contextTimeout time.Duration
+}
+
+func NewAuditUsecase(ar audit.Repository, timeout time.Duration) audit.Usecase {
+	return &auditUsecase{
+		auditRepo:      ar,
+		contextTimeout: timeout,
+	}
+}
+
+func (au *auditUsecase) Fetch(ctx context.Context, filter models.AuditFilter) (*[]models.AuditEntry, error) {
+	ctx, cancel := context.WithTimeout(ctx, au.contextTimeout)
+	defer cancel()
+
+	if filter.Limit == 0 {
+		filter.Limit = 50
+	}
+
+	if filter.Page == 0 {
+		filter.Page = 1
+	}
+
+	res, err := au.auditRepo.Fetch(ctx, filter)
+
+	return res, err
+}
+
+// HandleEvent turns a domain event into audit entries, one per entity the
+// write touched.
+func (au *auditUsecase) HandleEvent(env events.Envelope) error {
+	ctx, cancel := context.WithTimeout(context.Background(), au.contextTimeout)
+	defer cancel()
+
+	var entries []models.AuditEntry
+
+	add := func(action string, entity string, entityID string, before interface{}, after interface{}) error {
+		changes, err := diff(before, after)
+		if err != nil {
+			return err
+		}
+
+		if len(changes) == 0 {
+			return nil
+		}
+
+		raw, err := json.Marshal(changes)
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, models.AuditEntry{
+			EventID:   env.ID,
+			Actor:     env.Actor,
+			Action:    action,
+			Entity:    entity,
+			EntityID:  entityID,
+			Changes:   raw,
+			CreatedAt: env.OccurredAt,
+		})
+
+		return nil
+	}
+
+	var err error
+
+	switch e := env.Event.(type) {
+	case events.BranchCreated:
+		err = add(models.AuditCreate, models.AuditEntityBranch, e.Branch.ID.String(), nil, e.Branch)
+		if err == nil && e.Branch.BranchLocations.ID != uuid.Nil {
+			err = add(models.AuditCreate, models.AuditEntityBranchLocation, e.Branch.BranchLocations.ID.String(), nil, e.Branch.BranchLocations)
+		}
+	case events.BranchUpdated:
+		err = add(models.AuditUpdate, models.AuditEntityBranch, e.After.ID.String(), e.Before, e.After)
+		if err == nil {
+			err = locationChange(add, e.Before.BranchLocations, e.After.BranchLocations)
+		}
+	case events.BranchDeleted:
+		err = add(models.AuditDelete, models.AuditEntityBranch, e.Branch.ID.String(), e.Branch, nil)
+		if err == nil && e.Branch.BranchLocations.ID != uuid.Nil {
+			err = add(models.AuditDelete, models.AuditEntityBranchLocation, e.Branch.BranchLocations.ID.String(), e.Branch.BranchLocations, nil)
+		}
+	case events.BranchRestored:
+		err = add(models.AuditRestore, models.AuditEntityBranch, e.Branch.ID.String(), map[string]interface{}{"deleted_at": e.DeletedAt}, map[string]interface{}{"deleted_at": nil})
+	case events.MealPlanCreated:
+		err = add(models.AuditCreate, models.AuditEntityMealPlan, e.MealPlan.ID.String(), nil, e.MealPlan)
+	case events.MealPlanUpdated:
+		err = add(models.AuditUpdate, models.AuditEntityMealPlan, e.After.ID.String(), e.Before, e.After)
+	case events.MealPlanDeleted:
+		err = add(models.AuditDelete, models.AuditEntityMealPlan, e.MealPlan.ID.String(), e.MealPlan, nil)
+	case events.MealPlanRestored:
+		err = add(models.AuditRestore, models.AuditEntityMealPlan, e.MealPlan.ID.String(), map[string]interface{}{"deleted_at": e.DeletedAt}, map[string]interface{}{"deleted_at": nil})
+	case events.MealPlanLinked:
+		err = add(models.AuditCreate, models.AuditEntityBranchMealPlan, linkID(e.BranchID, e.MealPlanID), nil, e)
+	case events.MealPlanUnlinked:
+		err = add(models.AuditDelete, models.AuditEntityBranchMealPlan, linkID(e.BranchID, e.MealPlanID), e, nil)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	return au.auditRepo.Store(ctx, entries)
+}
+
+func locationChange(add func(string, string, string, interface{}, interface{}) error, before models.BranchLocation, after models.BranchLocation) error {
+	switch {
+	case before.ID == uuid.Nil && after.ID == uuid.Nil:
+		return nil
+	case before.ID == uuid.Nil:
+		return add(models.AuditCreate, models.AuditEntityBranchLocation, after.ID.String(), nil, after)
+	case after.ID == uuid.Nil:
+		return add(models.AuditDelete, models.AuditEntityBranchLocation, before.ID.String(), before, nil)
+	}
+
+	return add(models.AuditUpdate, models.AuditEntityBranchLocation, after.ID.String(), before, after)
+}
+
+func linkID(branchID uuid.UUID, mealPlanID uuid.UUID) string {
+	return branchID.String() + "/" + mealPlanID.String()
+}
+
+// diff compares the JSON representation of two snapshots field by field and
+// returns {"field": {"before": ..., "after": ...}} for every changed field. A
+// nil snapshot stands for a row that did not exist.
+func diff(before interface{}, after interface{}) (map[string]map[string]interface{}, error) {
+	b, err := fields(before)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := fields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(map[string]map[string]interface{})
+
+	for key, value := range a {
+		if ignoredFields[key] {
+			continue
+		}
+		if old, ok := b[key]; !ok || !reflect.DeepEqual(old, value) {
+			changes[key] = map[string]interface{}{"before": b[key], "after": value}
+		}
+	}
+
+	for key, old := range b {
+		if ignoredFields[key] {
+			continue
+		}
+		if _, ok := a[key]; !ok {
+			changes[key] = map[string]interface{}{"before": old, "after": nil}
+		}
+	}
+
+	return changes, nil
+}
+
+func fields(snapshot interface{}) (map[string]interface{}, error) {
+	m := map[string]interface{}{}
+
+	if snapshot == nil {
+		return m, nil
+	}
+
+	raw, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(raw, &m)
+
+	return m, err
+}
@@ -0,0 +1,141 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iamaul/fatbellies/app/availability"
+	"github.com/iamaul/fatbellies/app/models"
+	"github.com/iamaul/fatbellies/utils"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// maxWatched bounds how many branches and meal plans one stream watches
+	maxWatched = 100
+	// heartbeat keeps idle streams from being closed by proxies
+	heartbeat = 15 * time.Second
+)
+
+type AvailabilityHandler struct {
+	Availabilitycase availability.Usecase
+}
+
+func NewAvailabilityHandler(e *echo.Echo, au availability.Usecase) {
+	handler := &AvailabilityHandler{
+		Availabilitycase: au,
+	}
+
+	v1 := e.Group("/api/v1")
+	v1.GET("/availability/stream", handler.Stream)
+}
+
+// @Summary Stream availability changes
+// @Description Server-sent events pushed whenever the capacity or schedule of the watched branches or meal plan sessions changes. Each event is an availability change encoded as JSON.
+// @Tags Availability
+// @Produce  text/event-stream
+// @Param branch_id query string false "Comma separated branch IDs"
+// @Param meal_plan_id query string false "Comma separated meal plan IDs"
+// @Success 200 {object} models.AvailabilityChange
+// @Router /availability/stream [get]
+func (ah *AvailabilityHandler) Stream(c echo.Context) error {
+	filter, err := bindFilter(c)
+	if err != nil {
+		return err
+	}
+
+	changes, stop := ah.Availabilitycase.Watch(filter)
+	defer stop()
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set("Cache-Control", "no-cache")
+	res.Header().Set("Connection", "keep-alive")
+	// Tells nginx not to buffer the stream
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+
+	if _, err := fmt.Fprintf(res, "retry: %d\n\n", (3 * time.Second).Milliseconds()); err != nil {
+		return nil
+	}
+	res.Flush()
+
+	ticker := time.NewTicker(heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.Request().Context().Done():
+			return nil
+		case <-ticker.C:
+			if _, err := fmt.Fprint(res, ": ping\n\n"); err != nil {
+				return nil
+			}
+		case change, ok := <-changes:
+			if !ok {
+				// Fell behind; the client reconnects and reads the current state
+				return nil
+			}
+
+			data, err := json.Marshal(change)
+			if err != nil {
+				return nil
+			}
+
+			if _, err := fmt.Fprintf(res, "event: availability\ndata: %s\n\n", data); err != nil {
+				return nil
+			}
+		}
+		res.Flush()
+	}
+}
+
+// bindFilter parses the watched IDs, given as comma separated lists or as
+// repeated parameters
+func bindFilter(c echo.Context) (models.AvailabilityFilter, error) {
+	var filter models.AvailabilityFilter
+	var err error
+
+	if filter.BranchIDs, err = queryIDs(c, "branch_id"); err != nil {
+		return filter, err
+	}
+
+	if filter.MealPlanIDs, err = queryIDs(c, "meal_plan_id"); err != nil {
+		return filter, err
+	}
+
+	watched := len(filter.BranchIDs) + len(filter.MealPlanIDs)
+	if watched == 0 {
+		return filter, utils.InvalidField("branch_id", "required", "branch_id or meal_plan_id is required")
+	}
+
+	if watched > maxWatched {
+		return filter, utils.InvalidField("branch_id", "max", fmt.Sprintf("at most %d branches and meal plans can be watched", maxWatched))
+	}
+
+	return filter, nil
+}
+
+func queryIDs(c echo.Context, name string) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+
+	for _, param := range c.QueryParams()[name] {
+		for _, raw := range strings.Split(param, ",") {
+			if raw = strings.TrimSpace(raw); raw == "" {
+				continue
+			}
+
+			id, err := uuid.Parse(raw)
+			if err != nil {
+				return nil, utils.InvalidField(name, "uuid", name+" must be a list of IDs")
+			}
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, nil
+}
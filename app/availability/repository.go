@@ -0,0 +1,12 @@
+package availability
+
+import (
+	"github.com/iamaul/fatbellies/app/models"
+)
+
+// Repository represent the availability's change feed, shared by every
+// replica of the API
+type Repository interface {
+	Publish(change models.AvailabilityChange) error
+	Listen() <-chan models.AvailabilityChange
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"encoding/json"
+
+	"github.com/go-redis/redis"
+	"github.com/iamaul/fatbellies/app/availability"
+	"github.com/iamaul/fatbellies/app/models"
+	"github.com/sirupsen/logrus"
+)
+
+const channel = "fatbellies:availability"
+
+type redisAvailabilityRepository struct {
+	Client *redis.Client
+}
+
+// NewRedisAvailabilityRepository carries changes over Redis pub/sub, so a
+// change relayed by one replica reaches the clients of all of them.
+func NewRedisAvailabilityRepository(client *redis.Client) availability.Repository {
+	return &redisAvailabilityRepository{client}
+}
+
+func (ar *redisAvailabilityRepository) Publish(change models.AvailabilityChange) error {
+	payload, err := json.Marshal(change)
+	if err != nil {
+		return err
+	}
+
+	return ar.Client.Publish(channel, payload).Err()
+}
+
+// Listen subscribes to the channel for the lifetime of the process. The
+// client resubscribes by itself after losing the connection; changes
+// published meanwhile are missed.
+func (ar *redisAvailabilityRepository) Listen() <-chan models.AvailabilityChange {
+	res := make(chan models.AvailabilityChange)
+	pubsub := ar.Client.Subscribe(channel)
+
+	go func() {
+		defer close(res)
+
+		for msg := range pubsub.Channel() {
+			var change models.AvailabilityChange
+			if err := json.Unmarshal([]byte(msg.Payload), &change); err != nil {
+				logrus.WithField("channel", channel).Error(err)
+				continue
+			}
+			res <- change
+		}
+	}()
+
+	return res
+}
@@ -0,0 +1,13 @@
+package availability
+
+import (
+	"github.com/iamaul/fatbellies/app/events"
+	"github.com/iamaul/fatbellies/app/models"
+)
+
+// Usecase represent the availability's usecases
+type Usecase interface {
+	HandleEvent(env events.Envelope) error
+	Watch(filter models.AvailabilityFilter) (<-chan models.AvailabilityChange, func())
+	Run()
+}
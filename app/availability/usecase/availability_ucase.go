@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iamaul/fatbellies/app/availability"
+	"github.com/iamaul/fatbellies/app/events"
+	mealPlan "github.com/iamaul/fatbellies/app/meal_plan"
+	"github.com/iamaul/fatbellies/app/models"
+	"github.com/sirupsen/logrus"
+)
+
+// watcherBuffer is how many changes a client can fall behind before it is
+// disconnected. Clients reconnect and read the current state again, which
+// is cheaper than replaying what they missed.
+const watcherBuffer = 16
+
+type watcher struct {
+	filter  models.AvailabilityFilter
+	changes chan models.AvailabilityChange
+}
+
+type availabilityUsecase struct {
+	availabilityRepo availability.Repository
+	mealPlanRepo     mealPlan.Repository
+	contextTimeout   time.Duration
+
+	mu       sync.Mutex
+	watchers map[*watcher]struct{}
+}
+
+func NewAvailabilityUsecase(ar availability.Repository, mpr mealPlan.Repository, timeout time.Duration) availability.Usecase {
+	return &availabilityUsecase{
+		availabilityRepo: ar,
+		mealPlanRepo:     mpr,
+		contextTimeout:   timeout,
+		watchers:         make(map[*watcher]struct{}),
+	}
+}
+
+// HandleEvent publishes the availability change a domain event makes, if
+// any. Changes to meal plans are addressed to the branches offering them
+// once the write committed, so a deleted meal plan only reaches clients
+// watching the meal plan itself.
+//
+// Pushing changes is best effort and never fails the event: a retry would
+// deliver it to every other subscriber, webhooks included, again. Clients
+// that miss a change read the current state when they reconnect.
+func (au *availabilityUsecase) HandleEvent(env events.Envelope) error {
+	ctx, cancel := context.WithTimeout(context.Background(), au.contextTimeout)
+	defer cancel()
+
+	change := models.AvailabilityChange{
+		Event:      env.Event.Name(),
+		OccurredAt: env.OccurredAt,
+	}
+
+	switch e := env.Event.(type) {
+	case events.BranchUpdated:
+		if e.Before.OpeningHours == e.After.OpeningHours {
+			return nil
+		}
+		change.BranchIDs = []uuid.UUID{e.After.ID}
+		change.OpeningHours = &e.After.OpeningHours
+	case events.BranchDeleted:
+		change.BranchIDs = []uuid.UUID{e.Branch.ID}
+	case events.BranchRestored:
+		change.BranchIDs = []uuid.UUID{e.Branch.ID}
+	case events.MealPlanUpdated:
+		if !sessionChanged(e.Before, e.After) {
+			return nil
+		}
+		setSession(&change, e.After)
+	case events.MealPlanDeleted:
+		setSession(&change, e.MealPlan)
+	case events.MealPlanRestored:
+		setSession(&change, e.MealPlan)
+	case events.MealPlanLinked:
+		change.BranchIDs = []uuid.UUID{e.BranchID}
+		change.MealPlanID = &e.MealPlanID
+	case events.MealPlanUnlinked:
+		change.BranchIDs = []uuid.UUID{e.BranchID}
+		change.MealPlanID = &e.MealPlanID
+	default:
+		return nil
+	}
+
+	if change.BranchIDs == nil {
+		links, err := au.mealPlanRepo.BranchLinks(ctx, []uuid.UUID{*change.MealPlanID})
+		if err != nil {
+			dropped(change, err)
+			return nil
+		}
+
+		change.BranchIDs = make([]uuid.UUID, len(links))
+		for i, l := range links {
+			change.BranchIDs[i] = l.BranchID
+		}
+	}
+
+	if err := au.availabilityRepo.Publish(change); err != nil {
+		dropped(change, err)
+	}
+
+	return nil
+}
+
+func dropped(change models.AvailabilityChange, err error) {
+	logrus.WithFields(logrus.Fields{
+		"component": "availability",
+		"event":     change.Event,
+	}).WithError(err).Warn("dropping an availability change")
+}
+
+// sessionChanged reports whether an update touched what clients can book
+func sessionChanged(before models.MealPlan, after models.MealPlan) bool {
+	return before.MaxCapacity != after.MaxCapacity ||
+		before.Day != after.Day ||
+		before.StartClock != after.StartClock ||
+		before.EndClock != after.EndClock ||
+		before.Timezone != after.Timezone
+}
+
+func setSession(change *models.AvailabilityChange, p models.MealPlan) {
+	change.MealPlanID = &p.ID
+	change.MaxCapacity = &p.MaxCapacity
+	change.Day = p.Day
+	change.StartTime = &p.StartTime
+	change.EndTime = &p.EndTime
+}
+
+// Watch returns the changes matching filter until stop is called. The
+// channel is closed early when the client falls too far behind.
+func (au *availabilityUsecase) Watch(filter models.AvailabilityFilter) (<-chan models.AvailabilityChange, func()) {
+	w := &watcher{
+		filter:  filter,
+		changes: make(chan models.AvailabilityChange, watcherBuffer),
+	}
+
+	au.mu.Lock()
+	au.watchers[w] = struct{}{}
+	au.mu.Unlock()
+
+	stop := func() {
+		au.mu.Lock()
+		defer au.mu.Unlock()
+
+		if _, ok := au.watchers[w]; ok {
+			delete(au.watchers, w)
+			close(w.changes)
+		}
+	}
+
+	return w.changes, stop
+}
+
+// Run fans the changes published by every replica out to the watchers of
+// this one. It blocks, so callers usually start it in its own goroutine.
+func (au *availabilityUsecase) Run() {
+	for change := range au.availabilityRepo.Listen() {
+		au.mu.Lock()
+		for w := range au.watchers {
+			if !w.filter.Matches(change) {
+				continue
+			}
+
+			select {
+			case w.changes <- change:
+			default:
+				logrus.WithField("component", "availability").Warn("dropping a watcher that fell behind")
+				delete(au.watchers, w)
+				close(w.changes)
+			}
+		}
+		au.mu.Unlock()
+	}
+}
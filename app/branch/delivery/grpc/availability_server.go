@@ -0,0 +1,78 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/iamaul/fatbellies/app/branch"
+	pb "github.com/iamaul/fatbellies/proto/fatbellies/v1"
+	"github.com/iamaul/fatbellies/utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AvailabilityServer derives availability from the meal plans a branch
+// offers. Reservations are not recorded yet, so a slot's capacity is the
+// meal plan's full seating.
+type AvailabilityServer struct {
+	pb.UnimplementedAvailabilityServiceServer
+	Branchcase branch.Usecase
+}
+
+func (as *AvailabilityServer) GetBranchAvailability(ctx context.Context, req *pb.GetBranchAvailabilityRequest) (*pb.BranchAvailability, error) {
+	id, err := parseID(req.BranchId)
+	if err != nil {
+		return nil, utils.GRPCError(err)
+	}
+
+	res, err := as.Branchcase.GetByID(ctx, id)
+	if err != nil {
+		return nil, utils.GRPCError(err)
+	}
+
+	loc, err := utils.LoadTimezone(res.Timezone)
+	if err != nil {
+		return nil, utils.GRPCError(err)
+	}
+
+	// The weekday is the one at the branch, which can differ from UTC's
+	date := time.Now()
+	if req.Date != nil {
+		date = req.Date.AsTime()
+	}
+	date = date.In(loc)
+	day := date.Weekday().String()
+
+	slots := []*pb.AvailabilitySlot{}
+	for _, p := range res.MealPlans {
+		if weekday, ok := utils.ParseWeekday(p.Day); !ok || weekday != date.Weekday() {
+			continue
+		}
+
+		// The session held on that date, in the meal plan's timezone
+		planLoc, err := utils.LoadTimezone(p.Timezone)
+		if err != nil {
+			planLoc = loc
+		}
+
+		startsAt, endsAt, err := utils.SessionOn(date, p.StartClock, p.EndClock, planLoc)
+		if err != nil {
+			continue
+		}
+
+		slots = append(slots, &pb.AvailabilitySlot{
+			MealPlanId:   p.ID.String(),
+			MealPlanName: p.MealPlanName,
+			StartTime:    timestamppb.New(startsAt),
+			EndTime:      timestamppb.New(endsAt),
+			Capacity:     uint32(p.MaxCapacity),
+			Price:        p.Price,
+		})
+	}
+
+	return &pb.BranchAvailability{
+		BranchId: res.ID.String(),
+		Day:      day,
+		Slots:    slots,
+		Timezone: res.Timezone,
+	}, nil
+}
@@ -0,0 +1,301 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/iamaul/fatbellies/app/branch"
+	"github.com/iamaul/fatbellies/app/models"
+	pb "github.com/iamaul/fatbellies/proto/fatbellies/v1"
+	"github.com/iamaul/fatbellies/utils"
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultPageSize = 10
+	maxPageSize     = 100
+)
+
+var branchOrders = map[pb.BranchOrder]string{
+	pb.BranchOrder_BRANCH_ORDER_CREATED_AT_DESC: "created_at desc, id",
+	pb.BranchOrder_BRANCH_ORDER_CREATED_AT_ASC:  "created_at, id",
+	pb.BranchOrder_BRANCH_ORDER_NAME_ASC:        "branch_name, id",
+	pb.BranchOrder_BRANCH_ORDER_NAME_DESC:       "branch_name desc, id",
+}
+
+type BranchServer struct {
+	pb.UnimplementedBranchServiceServer
+	Branchcase branch.Usecase
+}
+
+func NewBranchServer(s *ggrpc.Server, bu branch.Usecase) {
+	server := &BranchServer{
+		Branchcase: bu,
+	}
+
+	pb.RegisterBranchServiceServer(s, server)
+	pb.RegisterAvailabilityServiceServer(s, &AvailabilityServer{Branchcase: bu})
+}
+
+func (bs *BranchServer) ListBranches(ctx context.Context, req *pb.ListBranchesRequest) (*pb.ListBranchesResponse, error) {
+	limit, page := pageLimits(req.PageSize, req.Page)
+
+	res, err := bs.Branchcase.List(ctx, limit, page, branchOrders[req.OrderBy], req.Search)
+	if err != nil {
+		return nil, utils.GRPCError(err)
+	}
+
+	branches := make([]*pb.Branch, len(res))
+	for i := range res {
+		branches[i] = branchToProto(res[i])
+	}
+
+	return &pb.ListBranchesResponse{Branches: branches}, nil
+}
+
+func (bs *BranchServer) StreamBranches(req *pb.StreamBranchesRequest, stream pb.BranchService_StreamBranchesServer) error {
+	ctx := stream.Context()
+
+	for page := int64(1); ; page++ {
+		res, err := bs.Branchcase.List(ctx, maxPageSize, page, branchOrders[req.OrderBy], req.Search)
+		if err != nil {
+			return utils.GRPCError(err)
+		}
+
+		for i := range res {
+			if err := stream.Send(branchToProto(res[i])); err != nil {
+				return err
+			}
+		}
+
+		if len(res) < maxPageSize {
+			return nil
+		}
+	}
+}
+
+func (bs *BranchServer) GetBranch(ctx context.Context, req *pb.GetBranchRequest) (*pb.Branch, error) {
+	id, err := parseID(req.Id)
+	if err != nil {
+		return nil, utils.GRPCError(err)
+	}
+
+	res, err := bs.Branchcase.GetByID(ctx, id)
+	if err != nil {
+		return nil, utils.GRPCError(err)
+	}
+
+	return branchToProto(res), nil
+}
+
+func (bs *BranchServer) NearestBranches(ctx context.Context, req *pb.NearestBranchesRequest) (*pb.NearestBranchesResponse, error) {
+	limit, _ := pageLimits(req.Limit, 1)
+
+	res, err := bs.Branchcase.FindNearestLocation(ctx, req.Latitude, req.Longitude)
+	if err != nil {
+		return nil, utils.GRPCError(err)
+	}
+
+	locations := *res
+	if int64(len(locations)) > limit {
+		locations = locations[:limit]
+	}
+
+	branches := make([]*pb.NearbyBranch, len(locations))
+	for i, l := range locations {
+		branches[i] = &pb.NearbyBranch{
+			BranchId: l.BranchID.String(),
+			Location: &pb.Location{Latitude: l.Latitude, Longitude: l.Longitude},
+			Distance: l.Distance,
+		}
+	}
+
+	return &pb.NearestBranchesResponse{Branches: branches}, nil
+}
+
+func (bs *BranchServer) CreateBranch(ctx context.Context, req *pb.CreateBranchRequest) (*pb.Branch, error) {
+	mealPlanIDs, err := parseIDs(req.MealPlanIds)
+	if err != nil {
+		return nil, utils.GRPCError(err)
+	}
+
+	b := models.Branch{
+		BranchName:      req.Name,
+		OpeningHours:    uint8(req.OpeningHours),
+		BranchLocations: locationFromProto(req.Location),
+		Timezone:        req.Timezone,
+		MealPlanIDs:     mealPlanIDs,
+	}
+
+	if err := utils.NewValidator().Struct(&b); err != nil {
+		return nil, utils.GRPCError(utils.Validation(err))
+	}
+
+	res, err := bs.Branchcase.Store(ctx, &b, utils.GRPCActor(ctx))
+	if err != nil {
+		return nil, utils.GRPCError(err)
+	}
+
+	return branchToProto(*res), nil
+}
+
+func (bs *BranchServer) UpdateBranch(ctx context.Context, req *pb.UpdateBranchRequest) (*pb.Branch, error) {
+	id, err := parseID(req.Id)
+	if err != nil {
+		return nil, utils.GRPCError(err)
+	}
+
+	if req.Version == 0 {
+		return nil, utils.GRPCError(utils.InvalidField("version", "required", "version is required"))
+	}
+
+	// The update goes through Patch, which writes every field it is given,
+	// zero values and the location included
+	fields := map[string]interface{}{
+		"branch_name":   req.Name,
+		"opening_hours": req.OpeningHours,
+	}
+	if req.Timezone != "" {
+		fields["timezone"] = req.Timezone
+	}
+	if req.Location != nil {
+		fields["locations"] = models.LocationPatch{
+			Latitude:  req.Location.GetLatitude(),
+			Longitude: req.Location.GetLongitude(),
+		}
+	}
+
+	patch, err := json.Marshal(fields)
+	if err != nil {
+		return nil, utils.GRPCError(err)
+	}
+
+	res, err := bs.Branchcase.Patch(ctx, id, req.Version, patch, utils.GRPCActor(ctx))
+	if err != nil {
+		return nil, utils.GRPCError(err)
+	}
+
+	return branchToProto(res), nil
+}
+
+func (bs *BranchServer) DeleteBranch(ctx context.Context, req *pb.DeleteBranchRequest) (*emptypb.Empty, error) {
+	id, err := parseID(req.Id)
+	if err != nil {
+		return nil, utils.GRPCError(err)
+	}
+
+	if req.Version == 0 {
+		return nil, utils.GRPCError(utils.InvalidField("version", "required", "version is required"))
+	}
+
+	if err := bs.Branchcase.Delete(ctx, id, req.Version, utils.GRPCActor(ctx)); err != nil {
+		return nil, utils.GRPCError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (bs *BranchServer) SetBranchMealPlans(ctx context.Context, req *pb.SetBranchMealPlansRequest) (*pb.MealPlanLinkChanges, error) {
+	id, err := parseID(req.BranchId)
+	if err != nil {
+		return nil, utils.GRPCError(err)
+	}
+
+	mealPlanIDs, err := parseIDs(req.MealPlanIds)
+	if err != nil {
+		return nil, utils.GRPCError(err)
+	}
+
+	res, err := bs.Branchcase.SetMealPlans(ctx, id, mealPlanIDs, utils.GRPCActor(ctx))
+	if err != nil {
+		return nil, utils.GRPCError(err)
+	}
+
+	return &pb.MealPlanLinkChanges{
+		BranchId:    res.BranchID.String(),
+		Linked:      idStrings(res.Linked),
+		Unlinked:    idStrings(res.Unlinked),
+		MealPlanIds: idStrings(res.MealPlanIDs),
+	}, nil
+}
+
+func branchToProto(b models.Branch) *pb.Branch {
+	mealPlanIDs := make([]string, len(b.MealPlans))
+	for i, p := range b.MealPlans {
+		mealPlanIDs[i] = p.ID.String()
+	}
+
+	return &pb.Branch{
+		Id:           b.ID.String(),
+		Name:         b.BranchName,
+		OpeningHours: uint32(b.OpeningHours),
+		Location: &pb.Location{
+			Latitude:  b.BranchLocations.Latitude,
+			Longitude: b.BranchLocations.Longitude,
+		},
+		MealPlanIds: mealPlanIDs,
+		Version:     b.Version,
+		CreatedAt:   timestamppb.New(b.CreatedAt),
+		UpdatedAt:   timestamppb.New(b.UpdatedAt),
+		Timezone:    b.Timezone,
+	}
+}
+
+func locationFromProto(l *pb.Location) models.BranchLocation {
+	return models.BranchLocation{
+		Latitude:  l.GetLatitude(),
+		Longitude: l.GetLongitude(),
+	}
+}
+
+// pageLimits applies the defaults and bounds of the list requests and returns
+// them as the usecases take them
+func pageLimits(size int32, page int32) (int64, int64) {
+	limit := int64(size)
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	if page < 1 {
+		page = 1
+	}
+
+	return limit, int64(page)
+}
+
+func parseID(id string) (uuid.UUID, error) {
+	res, err := uuid.Parse(id)
+	if err != nil {
+		return res, utils.ErrInvalidID.Wrap(err)
+	}
+
+	return res, nil
+}
+
+func parseIDs(ids []string) ([]uuid.UUID, error) {
+	res := make([]uuid.UUID, len(ids))
+	for i, id := range ids {
+		parsed, err := parseID(id)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = parsed
+	}
+
+	return res, nil
+}
+
+func idStrings(ids []uuid.UUID) []string {
+	res := make([]string, len(ids))
+	for i, id := range ids {
+		res[i] = id.String()
+	}
+
+	return res
+}
@@ -1,10 +1,10 @@
 package http
 
 import (
+	"io/ioutil"
 	"net/http"
 	"strconv"
 
-	"github.com/go-playground/validator"
 	"github.com/google/uuid"
 	"github.com/iamaul/fatbellies/app/branch"
 	"github.com/iamaul/fatbellies/app/models"
@@ -21,16 +21,41 @@ func NewBranchHandler(e *echo.Echo, bu branch.Usecase) {
 		Branchcase: bu,
 	}
 
+	v1 := e.Group("/api/v1")
+	v1.GET("/branches", handler.Fetch)
+	v1.POST("/branches", handler.Store)
+	v1.GET("/branches/nearest", handler.FindNearestLocation)
+	v1.GET("/branches/:id", handler.GetByID)
+	v1.PUT("/branches/:id", handler.Update)
+	v1.PATCH("/branches/:id", handler.Patch)
+	v1.DELETE("/branches/:id", handler.Delete)
+	v1.POST("/branches/:id/restore", handler.Restore)
+	v1.GET("/branches/:id/translations", handler.FetchTranslations)
+	v1.PUT("/branches/:id/translations/:locale", handler.StoreTranslation)
+	v1.DELETE("/branches/:id/translations/:locale", handler.DeleteTranslation)
+	v1.GET("/branches/:id/mealplans", handler.FetchMealPlans)
+	v1.PUT("/branches/:id/mealplans", handler.SetMealPlans)
+	v1.POST("/branches/:id/mealplans", handler.AddMealPlans)
+	v1.DELETE("/branches/:id/mealplans", handler.RemoveMealPlans)
+	v1.POST("/branches/:id/mealplans/copy", handler.CopyMealPlans)
+
+	// Deprecated aliases of the v1 routes, served until utils.LegacySunset
 	g := e.Group("/api")
-	g.GET("/branches", handler.Fetch)
-	g.GET("/branches/:id", handler.GetByID)
-	g.GET("/branches/branch/:name", handler.GetByName)
-	g.POST("/branches", handler.Store)
-	g.POST("/branches/mealplans", handler.StoreMealPlan)
-	g.DELETE("/delete/branches/:id", handler.Delete)
-	g.PUT("/update/branches/:id", handler.Update)
-	g.POST("/search/branches", handler.SearchBranches)
-	g.GET("/nearest/branches", handler.FindNearestLocation)
+	g.GET("/branches", handler.Fetch, utils.Deprecated("/api/v1/branches"))
+	g.GET("/branches/:id", handler.GetByID, utils.Deprecated("/api/v1/branches/:id"))
+	g.GET("/branches/branch/:name", handler.GetByName, utils.Deprecated("/api/v1/branches"))
+	g.POST("/branches", handler.Store, utils.Deprecated("/api/v1/branches"))
+	g.POST("/branches/mealplans", handler.StoreMealPlan, utils.Deprecated(""))
+	g.PUT("/branches/:id/mealplans", handler.SetMealPlans, utils.Deprecated("/api/v1/branches/:id/mealplans"))
+	g.POST("/branches/:id/mealplans", handler.AddMealPlans, utils.Deprecated("/api/v1/branches/:id/mealplans"))
+	g.DELETE("/branches/:id/mealplans", handler.RemoveMealPlans, utils.Deprecated("/api/v1/branches/:id/mealplans"))
+	g.POST("/branches/:id/mealplans/copy", handler.CopyMealPlans, utils.Deprecated("/api/v1/branches/:id/mealplans/copy"))
+	g.DELETE("/delete/branches/:id", handler.Delete, utils.Deprecated("/api/v1/branches/:id"))
+	g.POST("/branches/:id/restore", handler.Restore, utils.Deprecated("/api/v1/branches/:id/restore"))
+	g.PUT("/update/branches/:id", handler.Update, utils.Deprecated("/api/v1/branches/:id"))
+	g.PATCH("/branches/:id", handler.Patch, utils.Deprecated("/api/v1/branches/:id"))
+	g.POST("/search/branches", handler.SearchBranches, utils.Deprecated("/api/v1/branches"))
+	g.GET("/nearest/branches", handler.FindNearestLocation, utils.Deprecated("/api/v1/branches/nearest"))
 }
 
 // @Summary List branches
@@ -40,24 +65,28 @@ func NewBranchHandler(e *echo.Echo, bu branch.Usecase) {
 // @Produce  json
 // @Param limit query integer 5 "limit numbers"
 // @Param page query integer 1 "pagination"
-// @Param order query string false "created_at desc"
+// @Param order query string false "created_at, updated_at, branch_name, opening_hours, optionally followed by asc or desc; defaults to created_at desc"
+// @Param include_deleted query boolean false "include soft deleted branches"
+// @Param q query string false "only branches whose name contains q"
 // @Success 200 {array} models.Branch
-// @Router /api/branches [get]
+// @Router /branches [get]
 func (bh *BranchHandler) Fetch(c echo.Context) error {
+	ctx := c.Request().Context()
+
 	queryLimit := c.QueryParam("limit")
 	limit, _ := strconv.Atoi(queryLimit)
 	queryPage := c.QueryParam("page")
 	page, _ := strconv.Atoi(queryPage)
 	queryOrder := c.QueryParam("order")
+	includeDeleted, _ := strconv.ParseBool(c.QueryParam("include_deleted"))
+
+	if q := c.QueryParam("q"); q != "" {
+		return bh.search(c, "branch_name", q, queryOrder)
+	}
 
-	res, err := bh.Branchcase.Fetch(int64(limit), int64(page), queryOrder)
+	res, err := bh.Branchcase.Fetch(ctx, int64(limit), int64(page), queryOrder, includeDeleted)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, &utils.ResponseJSON{
-			Code:    http.StatusInternalServerError,
-			Message: "An unexpected error has occurred",
-			Error:   err.Error(),
-			Success: false,
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, &utils.ResponseJSON{
@@ -68,32 +97,60 @@ func (bh *BranchHandler) Fetch(c echo.Context) error {
 	})
 }
 
+// @Summary List branch meal plans
+// @Description Get the meal plans linked to a branch
+// @Tags Branches
+// @Accept  json
+// @Produce  json
+// @Param id path string uuid "Branch ID"
+// @Success 200 {array} models.MealPlan
+// @Router /branches/{id}/mealplans [get]
+func (bh *BranchHandler) FetchMealPlans(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return utils.ErrInvalidID.Wrap(err)
+	}
+
+	res, err := bh.Branchcase.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &utils.ResponseJSON{
+		Code:    http.StatusOK,
+		Result:  res.MealPlans,
+		Message: "Fetched data successfully",
+		Success: true,
+	})
+}
+
 // @Summary Find one of all the branches
 // @Description Get branch by ID
 // @Tags Branches
 // @Accept  json
 // @Produce  json
 // @Param id path string uuid "Branch ID"
+// @Param If-None-Match header string false "ETag of a cached copy"
 // @Success 200 {array} models.Branch
-// @Router /api/branches/{id} [get]
+// @Success 304
+// @Router /branches/{id} [get]
 func (bh *BranchHandler) GetByID(c echo.Context) error {
+	ctx := c.Request().Context()
+
 	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, &utils.ResponseJSON{
-			Code:    http.StatusBadRequest,
-			Message: "An unexpected error has occurred",
-			Error:   err.Error(),
-			Success: false,
-		})
+		return utils.ErrInvalidID.Wrap(err)
 	}
 
-	res, err := bh.Branchcase.GetByID(id)
+	res, err := bh.Branchcase.GetByID(ctx, id)
 	if err != nil {
-		return c.JSON(http.StatusConflict, &utils.ResponseJSON{
-			Code:    http.StatusConflict,
-			Error:   err.Error(),
-			Success: false,
-		})
+		return err
+	}
+
+	if utils.NotModified(c, res.Version, res) {
+		return c.NoContent(http.StatusNotModified)
 	}
 
 	return c.JSON(http.StatusOK, &utils.ResponseJSON{
@@ -104,24 +161,20 @@ func (bh *BranchHandler) GetByID(c echo.Context) error {
 	})
 }
 
-// @Summary Find one of all the branches
-// @Description Get branch by Name
-// @Tags Branches
-// @Accept  json
-// @Produce  json
-// @Param name path string false "Branch name"
-// @Success 200 {array} models.Branch
-// @Router /api/branches/branch/{name} [get]
+// GetByName finds a branch by its exact name. It only backs the deprecated
+// /api/branches/branch/:name route, /api/v1/branches?q= replaces it.
 func (bh *BranchHandler) GetByName(c echo.Context) error {
+	ctx := c.Request().Context()
+
 	branchName := c.Param("name")
 
-	res, err := bh.Branchcase.GetByName(branchName)
+	res, err := bh.Branchcase.GetByName(ctx, branchName)
 	if err != nil {
-		return c.JSON(http.StatusConflict, &utils.ResponseJSON{
-			Code:    http.StatusConflict,
-			Error:   err.Error(),
-			Success: false,
-		})
+		return err
+	}
+
+	if utils.NotModified(c, res.Version, res) {
+		return c.NoContent(http.StatusNotModified)
 	}
 
 	return c.JSON(http.StatusOK, &utils.ResponseJSON{
@@ -140,21 +193,18 @@ func (bh *BranchHandler) GetByName(c echo.Context) error {
 // @Param lat query string false "Latitude"
 // @Param long query string false "Longitude"
 // @Success 200 {array} models.BranchLocation
-// @Router /api/nearest/branches [get]
+// @Router /branches/nearest [get]
 func (bh *BranchHandler) FindNearestLocation(c echo.Context) error {
+	ctx := c.Request().Context()
+
 	queryLat := c.QueryParam("lat")
 	latitude, _ := strconv.ParseFloat(queryLat, 64)
 	queryLong := c.QueryParam("long")
 	longitude, _ := strconv.ParseFloat(queryLong, 64)
 
-	res, err := bh.Branchcase.FindNearestLocation(latitude, longitude)
+	res, err := bh.Branchcase.FindNearestLocation(ctx, latitude, longitude)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, &utils.ResponseJSON{
-			Code:    http.StatusInternalServerError,
-			Message: "An unexpected error has occurred",
-			Error:   err.Error(),
-			Success: false,
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, &utils.ResponseJSON{
@@ -166,7 +216,7 @@ func (bh *BranchHandler) FindNearestLocation(c echo.Context) error {
 }
 
 func createBranchValidation(cb *models.Branch) (bool, error) {
-	validate := validator.New()
+	validate := utils.NewValidator()
 
 	err := validate.Struct(cb)
 	if err != nil {
@@ -182,39 +232,28 @@ func createBranchValidation(cb *models.Branch) (bool, error) {
 // @Produce  json
 // @Param branch body models.SwagBranch true "Form JSON"
 // @Success 200 {array} models.Branch
-// @Router /api/branches [post]
+// @Router /branches [post]
 func (bh *BranchHandler) Store(c echo.Context) error {
+	ctx := c.Request().Context()
+
 	var branch models.Branch
 
 	err := c.Bind(&branch)
 	if err != nil {
-		return c.JSON(http.StatusUnprocessableEntity, &utils.ResponseJSON{
-			Code:    http.StatusUnprocessableEntity,
-			Message: "An unexpected error has occurred",
-			Error:   err.Error(),
-			Success: false,
-		})
+		return utils.ErrInvalidBody.Wrap(err)
 	}
 
 	if ok, err := createBranchValidation(&branch); !ok {
-		return c.JSON(http.StatusBadRequest, &utils.ResponseJSON{
-			Code:    http.StatusBadRequest,
-			Message: "Validation invalid",
-			Error:   err.Error(),
-			Success: false,
-		})
+		return utils.Validation(err)
 	}
 
-	res, err := bh.Branchcase.Store(&branch)
+	res, err := bh.Branchcase.Store(ctx, &branch, utils.Actor(c))
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, &utils.ResponseJSON{
-			Code:    http.StatusInternalServerError,
-			Message: "An unexpected error has occurred",
-			Error:   err.Error(),
-			Success: false,
-		})
+		return err
 	}
 
+	utils.SetETag(c, res.Version, res)
+
 	return c.JSON(http.StatusCreated, &utils.ResponseJSON{
 		Code:    http.StatusCreated,
 		Result:  res,
@@ -224,7 +263,7 @@ func (bh *BranchHandler) Store(c echo.Context) error {
 }
 
 func createBranchMealPlanValidation(cb *models.BranchMealPlan) (bool, error) {
-	validate := validator.New()
+	validate := utils.NewValidator()
 
 	err := validate.Struct(cb)
 	if err != nil {
@@ -233,44 +272,26 @@ func createBranchMealPlanValidation(cb *models.BranchMealPlan) (bool, error) {
 	return true, nil
 }
 
-// @Summary Add branch meal plan
-// @Description Add new branch meal plan
-// @Tags Branches
-// @Accept  json
-// @Produce  json
-// @Param branch_meal_plan body models.BranchMealPlan true "Form JSON"
-// @Success 200 {array} models.BranchMealPlan
-// @Router /api/branches/mealplans [post]
+// StoreMealPlan links a single meal plan to a branch. It only backs the
+// deprecated /api/branches/mealplans route, /api/v1/branches/:id/mealplans
+// replaces it.
 func (bh *BranchHandler) StoreMealPlan(c echo.Context) error {
+	ctx := c.Request().Context()
+
 	var mealPlan models.BranchMealPlan
 
 	err := c.Bind(&mealPlan)
 	if err != nil {
-		return c.JSON(http.StatusUnprocessableEntity, &utils.ResponseJSON{
-			Code:    http.StatusUnprocessableEntity,
-			Message: "An unexpected error has occurred",
-			Error:   err.Error(),
-			Success: false,
-		})
+		return utils.ErrInvalidBody.Wrap(err)
 	}
 
 	if ok, err := createBranchMealPlanValidation(&mealPlan); !ok {
-		return c.JSON(http.StatusBadRequest, &utils.ResponseJSON{
-			Code:    http.StatusBadRequest,
-			Message: "Validation invalid",
-			Error:   err.Error(),
-			Success: false,
-		})
+		return utils.Validation(err)
 	}
 
-	err = bh.Branchcase.StoreMealPlan(&mealPlan)
+	err = bh.Branchcase.StoreMealPlan(ctx, &mealPlan, utils.Actor(c))
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, &utils.ResponseJSON{
-			Code:    http.StatusInternalServerError,
-			Message: "An unexpected error has occurred",
-			Error:   err.Error(),
-			Success: false,
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusCreated, &utils.ResponseJSON{
@@ -280,56 +301,241 @@ func (bh *BranchHandler) StoreMealPlan(c echo.Context) error {
 	})
 }
 
+// bindAssignment parses the branch ID and the meal plan IDs of a bulk link
+// request. An empty list is only accepted when allowEmpty is set.
+func bindAssignment(c echo.Context, allowEmpty bool) (uuid.UUID, []uuid.UUID, error) {
+	var assignment models.MealPlanAssignment
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return id, nil, utils.ErrInvalidID.Wrap(err)
+	}
+
+	if err := c.Bind(&assignment); err != nil {
+		return id, nil, utils.ErrInvalidBody.Wrap(err)
+	}
+
+	if len(assignment.MealPlanIDs) == 0 && !allowEmpty {
+		return id, nil, utils.InvalidField("meal_plan_ids", "required", "meal_plan_ids is required")
+	}
+
+	return id, assignment.MealPlanIDs, nil
+}
+
+// @Summary Replace branch meal plans
+// @Description Link the branch to exactly the given meal plans, unlinking the others. An empty list unlinks every meal plan.
+// @Tags Branches
+// @Accept  json
+// @Produce  json
+// @Param id path string uuid "Branch ID"
+// @Param meal_plans body models.MealPlanAssignment true "Form JSON"
+// @Success 200 {object} models.MealPlanLinkChanges
+// @Router /branches/{id}/mealplans [put]
+func (bh *BranchHandler) SetMealPlans(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	id, mealPlanIDs, err := bindAssignment(c, true)
+	if err != nil {
+		return err
+	}
+
+	res, err := bh.Branchcase.SetMealPlans(ctx, id, mealPlanIDs, utils.Actor(c))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &utils.ResponseJSON{
+		Code:    http.StatusOK,
+		Result:  res,
+		Message: "Branch meal plans replaced successfully",
+		Success: true,
+	})
+}
+
+// @Summary Add branch meal plans
+// @Description Link many meal plans to the branch at once, skipping those already linked
+// @Tags Branches
+// @Accept  json
+// @Produce  json
+// @Param id path string uuid "Branch ID"
+// @Param meal_plans body models.MealPlanAssignment true "Form JSON"
+// @Success 200 {object} models.MealPlanLinkChanges
+// @Router /branches/{id}/mealplans [post]
+func (bh *BranchHandler) AddMealPlans(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	id, mealPlanIDs, err := bindAssignment(c, false)
+	if err != nil {
+		return err
+	}
+
+	res, err := bh.Branchcase.AddMealPlans(ctx, id, mealPlanIDs, utils.Actor(c))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &utils.ResponseJSON{
+		Code:    http.StatusOK,
+		Result:  res,
+		Message: "Branch meal plans added successfully",
+		Success: true,
+	})
+}
+
+// @Summary Remove branch meal plans
+// @Description Unlink many meal plans from the branch at once, skipping those not linked
+// @Tags Branches
+// @Accept  json
+// @Produce  json
+// @Param id path string uuid "Branch ID"
+// @Param meal_plans body models.MealPlanAssignment true "Form JSON"
+// @Success 200 {object} models.MealPlanLinkChanges
+// @Router /branches/{id}/mealplans [delete]
+func (bh *BranchHandler) RemoveMealPlans(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	id, mealPlanIDs, err := bindAssignment(c, false)
+	if err != nil {
+		return err
+	}
+
+	res, err := bh.Branchcase.RemoveMealPlans(ctx, id, mealPlanIDs, utils.Actor(c))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &utils.ResponseJSON{
+		Code:    http.StatusOK,
+		Result:  res,
+		Message: "Branch meal plans removed successfully",
+		Success: true,
+	})
+}
+
+// @Summary Copy branch meal plans
+// @Description Give other branches the same meal plans as this one, replacing what they were linked to
+// @Tags Branches
+// @Accept  json
+// @Produce  json
+// @Param id path string uuid "Source branch ID"
+// @Param branches body models.MealPlanCopy true "Form JSON"
+// @Success 200 {array} models.MealPlanLinkChanges
+// @Router /branches/{id}/mealplans/copy [post]
+func (bh *BranchHandler) CopyMealPlans(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var body models.MealPlanCopy
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return utils.ErrInvalidID.Wrap(err)
+	}
+
+	if err := c.Bind(&body); err != nil {
+		return utils.ErrInvalidBody.Wrap(err)
+	}
+
+	if err := utils.NewValidator().Struct(&body); err != nil {
+		return utils.Validation(err)
+	}
+
+	res, err := bh.Branchcase.CopyMealPlans(ctx, id, body.BranchIDs, utils.Actor(c))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &utils.ResponseJSON{
+		Code:    http.StatusOK,
+		Result:  res,
+		Message: "Branch meal plans copied successfully",
+		Success: true,
+	})
+}
+
 // @Summary Update branch
-// @Description Update branch by ID
+// @Description Update branch by ID. Meal plans held in the branch's timezone move with it when it changes.
 // @Tags Branches
 // @Accept  json
 // @Produce  json
+// @Param If-Match header string true "ETag of the version being changed"
 // @Param id path string uuid "Branch ID"
 // @Param branch body models.SwagBranch true "Form JSON"
 // @Success 200 {array} models.Branch
-// @Router /api/update/branches/{id} [put]
+// @Router /branches/{id} [put]
 func (bh *BranchHandler) Update(c echo.Context) error {
+	ctx := c.Request().Context()
+
 	var branch models.Branch
 
 	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, &utils.ResponseJSON{
-			Code:    http.StatusBadRequest,
-			Message: "An unexpected error has occurred",
-			Error:   err.Error(),
-			Success: false,
-		})
+		return utils.ErrInvalidID.Wrap(err)
+	}
+
+	version, err := utils.IfMatch(c)
+	if err != nil {
+		return err
 	}
 
 	errBind := c.Bind(&branch)
 	if errBind != nil {
-		return c.JSON(http.StatusUnprocessableEntity, &utils.ResponseJSON{
-			Code:    http.StatusUnprocessableEntity,
-			Message: "An unexpected error has occurred",
-			Error:   errBind.Error(),
-			Success: false,
-		})
+		return utils.ErrInvalidBody.Wrap(errBind)
 	}
 
 	if ok, errValidation := createBranchValidation(&branch); !ok {
-		return c.JSON(http.StatusBadRequest, &utils.ResponseJSON{
-			Code:    http.StatusBadRequest,
-			Message: "Validation invalid",
-			Error:   errValidation.Error(),
-			Success: false,
-		})
+		return utils.Validation(errValidation)
 	}
 
-	res, errBranch := bh.Branchcase.Update(id, branch)
+	res, errBranch := bh.Branchcase.Update(ctx, id, version, branch, utils.Actor(c))
 	if errBranch != nil {
-		return c.JSON(http.StatusNotFound, &utils.ResponseJSON{
-			Code:    http.StatusNotFound,
-			Error:   errBranch.Error(),
-			Success: false,
-		})
+		return errBranch
 	}
 
+	utils.SetETag(c, res.Version, res)
+
+	return c.JSON(http.StatusOK, &utils.ResponseJSON{
+		Code:    http.StatusOK,
+		Result:  res,
+		Message: "Branch updated successfully",
+		Success: true,
+	})
+}
+
+// @Summary Patch branch
+// @Description Partially update a branch and its location with a JSON merge patch (RFC 7396). Only the members sent are changed and validated, and null clears a field. Meal plans held in the branch's timezone move with it when it changes.
+// @Tags Branches
+// @Accept  application/merge-patch+json
+// @Produce  json
+// @Param If-Match header string true "ETag of the version being changed"
+// @Param id path string uuid "Branch ID"
+// @Param branch body models.BranchPatch true "JSON merge patch"
+// @Success 200 {object} models.Branch
+// @Router /branches/{id} [patch]
+func (bh *BranchHandler) Patch(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return utils.ErrInvalidID.Wrap(err)
+	}
+
+	version, err := utils.IfMatch(c)
+	if err != nil {
+		return err
+	}
+
+	patch, err := ioutil.ReadAll(c.Request().Body)
+	if err != nil {
+		return utils.ErrInvalidBody.Wrap(err)
+	}
+
+	res, err := bh.Branchcase.Patch(ctx, id, version, patch, utils.Actor(c))
+	if err != nil {
+		return err
+	}
+
+	utils.SetETag(c, res.Version, res)
+
 	return c.JSON(http.StatusOK, &utils.ResponseJSON{
 		Code:    http.StatusOK,
 		Result:  res,
@@ -343,27 +549,26 @@ func (bh *BranchHandler) Update(c echo.Context) error {
 // @Tags Branches
 // @Accept  json
 // @Produce  json
+// @Param If-Match header string true "ETag of the version being changed"
 // @Param id path string false "Branch ID"
 // @Success 200
-// @Router /api/delete/branches/{id} [delete]
+// @Router /branches/{id} [delete]
 func (bh *BranchHandler) Delete(c echo.Context) error {
+	ctx := c.Request().Context()
+
 	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, &utils.ResponseJSON{
-			Code:    http.StatusBadRequest,
-			Message: "An unexpected error has occurred",
-			Error:   err.Error(),
-			Success: false,
-		})
+		return utils.ErrInvalidID.Wrap(err)
 	}
 
-	err = bh.Branchcase.Delete(id)
+	version, err := utils.IfMatch(c)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, &utils.ResponseJSON{
-			Code:    http.StatusNotFound,
-			Error:   err.Error(),
-			Success: false,
-		})
+		return err
+	}
+
+	err = bh.Branchcase.Delete(ctx, id, version, utils.Actor(c))
+	if err != nil {
+		return err
 	}
 
 	return c.JSON(http.StatusOK, &utils.ResponseJSON{
@@ -373,29 +578,49 @@ func (bh *BranchHandler) Delete(c echo.Context) error {
 	})
 }
 
-// @Summary Search branches
-// @Description Search branch by specific queries column, q, and ID
+// @Summary Restore branch
+// @Description Restore a soft deleted branch with its location and meal plan links
 // @Tags Branches
 // @Accept  json
 // @Produce  json
-// @Param column query string false "branch_name"
-// @Param q query string false "restaurant"
-// @Param order query string false "created_at desc"
-// @Success 200 {array} models.Branch
-// @Router /api/search/branches [post]
+// @Param id path string uuid "Branch ID"
+// @Success 200 {object} models.Branch
+// @Router /branches/{id}/restore [post]
+func (bh *BranchHandler) Restore(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return utils.ErrInvalidID.Wrap(err)
+	}
+
+	res, err := bh.Branchcase.Restore(ctx, id, utils.Actor(c))
+	if err != nil {
+		return err
+	}
+
+	utils.SetETag(c, res.Version, res)
+
+	return c.JSON(http.StatusOK, &utils.ResponseJSON{
+		Code:    http.StatusOK,
+		Result:  res,
+		Message: "Branch restored successfully",
+		Success: true,
+	})
+}
+
+// SearchBranches only backs the deprecated /api/search/branches route,
+// /api/v1/branches?q= replaces it.
 func (bh *BranchHandler) SearchBranches(c echo.Context) error {
-	column := c.QueryParam("column")
-	label := c.QueryParam("q")
-	order := c.QueryParam("order")
+	return bh.search(c, c.QueryParam("column"), c.QueryParam("q"), c.QueryParam("order"))
+}
+
+func (bh *BranchHandler) search(c echo.Context, column string, label string, order string) error {
+	ctx := c.Request().Context()
 
-	res, err := bh.Branchcase.SearchBranches(column, label, order)
+	res, err := bh.Branchcase.SearchBranches(ctx, column, label, order)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, &utils.ResponseJSON{
-			Code:    http.StatusInternalServerError,
-			Message: "An unexpected error has occurred",
-			Error:   err.Error(),
-			Success: false,
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, &utils.ResponseJSON{
@@ -405,3 +630,103 @@ func (bh *BranchHandler) SearchBranches(c echo.Context) error {
 		Success: true,
 	})
 }
+
+// @Summary List branch translations
+// @Description Get the translations of a branch, one per locale. Requests sending Accept-Language get the branch in the first of their languages it has a translation in.
+// @Tags Branches
+// @Accept  json
+// @Produce  json
+// @Param id path string uuid "Branch ID"
+// @Success 200 {array} models.BranchTranslation
+// @Router /branches/{id}/translations [get]
+func (bh *BranchHandler) FetchTranslations(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return utils.ErrInvalidID.Wrap(err)
+	}
+
+	res, err := bh.Branchcase.Translations(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &utils.ResponseJSON{
+		Code:    http.StatusOK,
+		Result:  res,
+		Message: "Fetched data successfully",
+		Success: true,
+	})
+}
+
+// @Summary Store branch translation
+// @Description Create or replace the translation of a branch in a locale other than the default one
+// @Tags Branches
+// @Accept  json
+// @Produce  json
+// @Param id path string uuid "Branch ID"
+// @Param locale path string true "Language tag, such as id or zh-Hant"
+// @Param translation body models.SwagBranchTranslation true "Form JSON"
+// @Success 200 {object} models.BranchTranslation
+// @Router /branches/{id}/translations/{locale} [put]
+func (bh *BranchHandler) StoreTranslation(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return utils.ErrInvalidID.Wrap(err)
+	}
+
+	var translation models.BranchTranslation
+	if err := c.Bind(&translation); err != nil {
+		return utils.ErrInvalidBody.Wrap(err)
+	}
+
+	translation.BranchID = id
+	translation.Locale = c.Param("locale")
+
+	if err := utils.NewValidator().Struct(&translation); err != nil {
+		return utils.Validation(err)
+	}
+
+	res, err := bh.Branchcase.StoreTranslation(ctx, &translation)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &utils.ResponseJSON{
+		Code:    http.StatusOK,
+		Result:  res,
+		Message: "Branch translation saved successfully",
+		Success: true,
+	})
+}
+
+// @Summary Delete branch translation
+// @Description Delete the translation of a branch in a locale, after which it falls back to the next language of the request
+// @Tags Branches
+// @Accept  json
+// @Produce  json
+// @Param id path string uuid "Branch ID"
+// @Param locale path string true "Language tag"
+// @Success 200 {object} utils.ResponseJSON
+// @Router /branches/{id}/translations/{locale} [delete]
+func (bh *BranchHandler) DeleteTranslation(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return utils.ErrInvalidID.Wrap(err)
+	}
+
+	if err := bh.Branchcase.DeleteTranslation(ctx, id, c.Param("locale")); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &utils.ResponseJSON{
+		Code:    http.StatusOK,
+		Message: "Branch translation deleted successfully",
+		Success: true,
+	})
+}
@@ -1,19 +1,34 @@
 package branch
 
 import (
+	"context"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/iamaul/fatbellies/app/models"
 )
 
 // Repository represent the branch's repository contract
 type Repository interface {
-	Fetch(limit int64, offset int64, order string) (*[]models.Branch, error)
-	GetByID(id uuid.UUID) (models.Branch, error)
-	GetByName(name string) (models.Branch, error)
-	FindNearestLocation(lat float64, long float64) (*[]models.BranchLocation, error)
-	Store(branch *models.Branch) (*models.Branch, error)
-	StoreMealPlan(mealPlan *models.BranchMealPlan) error
-	Update(id uuid.UUID, branch models.Branch) (models.Branch, error)
-	Delete(id uuid.UUID) error
-	SearchBranches(column string, label string, order string) (*[]models.Branch, error)
+	Fetch(ctx context.Context, limit int64, offset int64, order string, includeDeleted bool) (*[]models.Branch, error)
+	GetByID(ctx context.Context, id uuid.UUID) (models.Branch, error)
+	GetByName(ctx context.Context, name string) (models.Branch, error)
+	List(ctx context.Context, limit int64, offset int64, order string, query string) ([]models.Branch, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Branch, error)
+	LocationsByBranch(ctx context.Context, branchIDs []uuid.UUID) ([]models.BranchLocation, error)
+	MealPlanLinks(ctx context.Context, branchIDs []uuid.UUID) ([]models.BranchMealPlan, error)
+	FindNearestLocation(ctx context.Context, lat float64, long float64) (*[]models.BranchLocation, error)
+	Store(ctx context.Context, branch *models.Branch, actor string) (*models.Branch, error)
+	StoreMealPlan(ctx context.Context, mealPlan *models.BranchMealPlan, actor string) error
+	MealPlanIDs(ctx context.Context, branchID uuid.UUID) ([]uuid.UUID, error)
+	DeleteMealPlan(ctx context.Context, mealPlan *models.BranchMealPlan, actor string) error
+	Update(ctx context.Context, id uuid.UUID, version uint64, branch models.Branch, actor string) (models.Branch, error)
+	Patch(ctx context.Context, id uuid.UUID, version uint64, patch models.BranchPatch, actor string) (models.Branch, error)
+	Delete(ctx context.Context, id uuid.UUID, version uint64, actor string) error
+	Restore(ctx context.Context, id uuid.UUID, actor string) (models.Branch, error)
+	Purge(ctx context.Context, before time.Time) (int64, error)
+	Translations(ctx context.Context, branchID uuid.UUID) ([]models.BranchTranslation, error)
+	StoreTranslation(ctx context.Context, translation *models.BranchTranslation) (*models.BranchTranslation, error)
+	DeleteTranslation(ctx context.Context, branchID uuid.UUID, locale string) error
+	SearchBranches(ctx context.Context, column string, label string, order string) (*[]models.Branch, error)
 }
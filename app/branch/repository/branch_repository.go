@@ -1,11 +1,15 @@
 package repository
 
 import (
-	"errors"
+	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/iamaul/fatbellies/app/branch"
+	"github.com/iamaul/fatbellies/app/events"
+	outbox "github.com/iamaul/fatbellies/app/events/repository"
 	"github.com/iamaul/fatbellies/app/models"
+	"github.com/iamaul/fatbellies/config/database"
 	"github.com/iamaul/fatbellies/utils"
 	"github.com/jinzhu/gorm"
 )
@@ -18,10 +22,19 @@ func NewBranchRepository(connection *gorm.DB) branch.Repository {
 	return &branchRepository{connection}
 }
 
-func (br *branchRepository) Fetch(limit int64, offset int64, order string) (res *[]models.Branch, err error) {
+func (br *branchRepository) conn(ctx context.Context) *gorm.DB {
+	return database.WithContext(ctx, br.Db)
+}
+
+func (br *branchRepository) Fetch(ctx context.Context, limit int64, offset int64, order string, includeDeleted bool) (res *[]models.Branch, err error) {
 	branch := &[]models.Branch{}
 
-	if err = br.Db.Model(&models.Branch{}).Limit(limit).Offset(limit * (offset - 1)).Order(order).Preload("MealPlans").Preload("BranchLocations").Find(&branch).Error; err != nil {
+	db := br.conn(ctx)
+	if includeDeleted {
+		db = db.Unscoped()
+	}
+
+	if err = db.Model(&models.Branch{}).Limit(limit).Offset(limit * (offset - 1)).Order(order).Preload("MealPlans").Preload("MealPlans.Translations").Preload("BranchLocations").Preload("Translations").Find(&branch).Error; err != nil {
 		return
 	}
 
@@ -30,14 +43,14 @@ func (br *branchRepository) Fetch(limit int64, offset int64, order string) (res
 	return
 }
 
-func (br *branchRepository) GetByID(id uuid.UUID) (res models.Branch, err error) {
+func (br *branchRepository) GetByID(ctx context.Context, id uuid.UUID) (res models.Branch, err error) {
 	branch := models.Branch{}
 
 	// ToDo: Redis cache get
 
-	if err = br.Db.Model(&models.Branch{}).Where("id = ?", id).Preload("MealPlans").Preload("BranchLocations").First(&branch).Error; err != nil {
+	if err = br.conn(ctx).Model(&models.Branch{}).Where("id = ?", id).Preload("MealPlans").Preload("MealPlans.Translations").Preload("BranchLocations").Preload("Translations").First(&branch).Error; err != nil {
 		if gorm.IsRecordNotFoundError(err) {
-			return
+			err = utils.ErrBranchNotFound
 		}
 		return
 	}
@@ -47,14 +60,14 @@ func (br *branchRepository) GetByID(id uuid.UUID) (res models.Branch, err error)
 	return
 }
 
-func (br *branchRepository) GetByName(name string) (res models.Branch, err error) {
+func (br *branchRepository) GetByName(ctx context.Context, name string) (res models.Branch, err error) {
 	branch := models.Branch{}
 
 	// ToDo: Redis cache get
 
-	if err = br.Db.Model(&models.Branch{}).Where("branch_name = ?", name).Preload("MealPlans").Preload("BranchLocations").First(&branch).Error; err != nil {
+	if err = br.conn(ctx).Model(&models.Branch{}).Where("branch_name = ?", name).Preload("MealPlans").Preload("MealPlans.Translations").Preload("BranchLocations").Preload("Translations").First(&branch).Error; err != nil {
 		if gorm.IsRecordNotFoundError(err) {
-			return
+			err = utils.ErrBranchNotFound
 		}
 		return
 	}
@@ -64,10 +77,45 @@ func (br *branchRepository) GetByName(name string) (res models.Branch, err error
 	return
 }
 
-func (br *branchRepository) FindNearestLocation(lat float64, long float64) (res *[]models.BranchLocation, err error) {
+// List returns a page of branches without their relations, only those whose
+// name contains query when it is set.
+func (br *branchRepository) List(ctx context.Context, limit int64, offset int64, order string, query string) (res []models.Branch, err error) {
+	db := br.conn(ctx).Model(&models.Branch{})
+	if query != "" {
+		db = db.Where("branch_name ILIKE ?", "%"+query+"%")
+	}
+
+	err = db.Limit(limit).Offset(limit * (offset - 1)).Order(order).Preload("Translations").Find(&res).Error
+
+	return
+}
+
+// GetByIDs returns the branches with the given IDs with only their
+// translations
+func (br *branchRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) (res []models.Branch, err error) {
+	err = br.conn(ctx).Model(&models.Branch{}).Where("id IN (?)", ids).Preload("Translations").Find(&res).Error
+
+	return
+}
+
+// LocationsByBranch returns the locations of the given branches
+func (br *branchRepository) LocationsByBranch(ctx context.Context, branchIDs []uuid.UUID) (res []models.BranchLocation, err error) {
+	err = br.conn(ctx).Model(&models.BranchLocation{}).Where("branch_id IN (?)", branchIDs).Find(&res).Error
+
+	return
+}
+
+// MealPlanLinks returns the meal plan links of the given branches
+func (br *branchRepository) MealPlanLinks(ctx context.Context, branchIDs []uuid.UUID) (res []models.BranchMealPlan, err error) {
+	err = br.conn(ctx).Model(&models.BranchMealPlan{}).Where("branch_id IN (?)", branchIDs).Find(&res).Error
+
+	return
+}
+
+func (br *branchRepository) FindNearestLocation(ctx context.Context, lat float64, long float64) (res *[]models.BranchLocation, err error) {
 	branchLocation := &[]models.BranchLocation{}
 
-	if err = br.Db.Model(&models.BranchLocation{}).Raw("SELECT branch_locations.id, branch_locations.branch_id, branch_locations.latitude, branch_locations.longitude, (3959 * acos(cos(radians(?)) * cos(radians(branch_locations.latitude)) * cos(radians(branch_locations.longitude) - radians(?)) + sin(radians(?)) * sin(radians(branch_locations.latitude)))) AS distance FROM branch_locations",
+	if err = br.conn(ctx).Model(&models.BranchLocation{}).Raw("SELECT branch_locations.id, branch_locations.branch_id, branch_locations.latitude, branch_locations.longitude, (3959 * acos(cos(radians(?)) * cos(radians(branch_locations.latitude)) * cos(radians(branch_locations.longitude) - radians(?)) + sin(radians(?)) * sin(radians(branch_locations.latitude)))) AS distance FROM branch_locations WHERE branch_locations.deleted_at IS NULL",
 		lat, long, lat).Order("distance").Find(&branchLocation).Error; err != nil {
 		return
 	}
@@ -77,66 +125,290 @@ func (br *branchRepository) FindNearestLocation(lat float64, long float64) (res
 	return
 }
 
-func (br *branchRepository) Store(branch *models.Branch) (res *models.Branch, err error) {
-	if err = br.Db.Model(&models.Branch{}).Where("branch_name = ?", branch.BranchName).Find(&models.Branch{}).Error; err != nil {
-		if gorm.IsRecordNotFoundError(err) {
-			tx := br.Db.Begin()
+// Store relies on the partial unique index on branch_name instead of reading
+// before inserting, so concurrent creates cannot both succeed.
+func (br *branchRepository) Store(ctx context.Context, branch *models.Branch, actor string) (res *models.Branch, err error) {
+	err = br.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&branch).Error; err != nil {
+			return err
+		}
 
-			if err := tx.Create(&branch).Error; err != nil {
-				tx.Rollback()
+		return outbox.Record(tx, actor, events.BranchCreated{Branch: *branch})
+	})
+	if err != nil {
+		err = utils.TranslateDBError(err)
+		return
+	}
+
+	res = branch
+
+	return
+}
+
+// StoreMealPlan links a meal plan to a branch. A link that was soft deleted
+// is brought back instead of colliding with the primary key, while an active
+// one is reported as a conflict. Soft deleted meal plans cannot be linked,
+// which the foreign key alone would allow.
+func (br *branchRepository) StoreMealPlan(ctx context.Context, mealPlan *models.BranchMealPlan, actor string) (err error) {
+	err = br.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		mealPlan.DeletedAt = nil
+
+		// Both ends must be live, and are locked so neither is deleted
+		// before the link is written. The foreign keys would accept soft
+		// deleted ones.
+		branch := models.Branch{}
+		if err := lock(tx, mealPlan.BranchID, 0, &branch); err != nil {
+			if gorm.IsRecordNotFoundError(err) {
+				return utils.ErrUnknownBranch
 			}
+			return err
+		}
+
+		if err := tx.Set("gorm:query_option", "FOR UPDATE").Where("id = ?", mealPlan.MealPlanID).First(&models.MealPlan{}).Error; err != nil {
+			if gorm.IsRecordNotFoundError(err) {
+				return utils.ErrUnknownMealPlan
+			}
+			return err
+		}
 
-			tx.Commit()
+		db := tx.Set("gorm:insert_option", "ON CONFLICT (branch_id, meal_plan_id) DO UPDATE SET deleted_at = NULL WHERE branch_meal_plans.deleted_at IS NOT NULL").Create(&mealPlan)
+		if db.Error != nil {
+			return db.Error
+		}
 
-			return branch, nil
+		if db.RowsAffected == 0 {
+			return utils.ErrBranchMealPlanExists
 		}
+
+		return outbox.Record(tx, actor, events.MealPlanLinked{BranchID: mealPlan.BranchID, MealPlanID: mealPlan.MealPlanID})
+	})
+	if err != nil {
+		err = utils.TranslateDBError(err)
 	}
 
-	err = errors.New(utils.BranchExists)
+	return
+}
+
+// MealPlanIDs returns the meal plans linked to the branch
+func (br *branchRepository) MealPlanIDs(ctx context.Context, branchID uuid.UUID) (res []uuid.UUID, err error) {
+	err = br.conn(ctx).Model(&models.BranchMealPlan{}).Where("branch_id = ?", branchID).Pluck("meal_plan_id", &res).Error
 
 	return
 }
 
-func (br *branchRepository) StoreMealPlan(mealPlan *models.BranchMealPlan) (err error) {
-	tx := br.Db.Begin()
+// DeleteMealPlan unlinks a meal plan from a branch by deleting the link.
+// Only links removed together with their branch or meal plan are soft
+// deleted, since the many2many preloads do not filter on the link's
+// deleted_at and would keep showing an unlinked meal plan.
+func (br *branchRepository) DeleteMealPlan(ctx context.Context, mealPlan *models.BranchMealPlan, actor string) (err error) {
+	err = br.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		db := tx.Unscoped().Where("branch_id = ? AND meal_plan_id = ? AND deleted_at IS NULL", mealPlan.BranchID, mealPlan.MealPlanID).Delete(&models.BranchMealPlan{})
+		if db.Error != nil {
+			return db.Error
+		}
 
-	if err := tx.Create(&mealPlan).Error; err != nil {
-		tx.Rollback()
-	}
+		if db.RowsAffected == 0 {
+			return utils.ErrBranchMealPlanNotFound
+		}
 
-	tx.Commit()
+		return outbox.Record(tx, actor, events.MealPlanUnlinked{BranchID: mealPlan.BranchID, MealPlanID: mealPlan.MealPlanID})
+	})
+	if err != nil {
+		err = utils.TranslateDBError(err)
+	}
 
 	return
 }
 
-func (br *branchRepository) Update(id uuid.UUID, newBranch models.Branch) (res models.Branch, err error) {
+// lock loads the branch into dest and holds its row until the transaction
+// ends. A version other than 0 must be the branch's current one.
+func lock(tx *gorm.DB, id uuid.UUID, version uint64, dest *models.Branch) error {
+	if err := tx.Set("gorm:query_option", "FOR UPDATE").Model(&models.Branch{}).Where("id = ?", id).Preload("BranchLocations").First(dest).Error; err != nil {
+		return err
+	}
+
+	if version != 0 && dest.Version != version {
+		return utils.ErrPreconditionFailed
+	}
+
+	return nil
+}
+
+func (br *branchRepository) Update(ctx context.Context, id uuid.UUID, version uint64, newBranch models.Branch, actor string) (res models.Branch, err error) {
+	before := models.Branch{}
 	branch := models.Branch{}
 
-	db := br.Db.Model(branch).Where("id = ?", id).UpdateColumns(newBranch)
-	if db.Error != nil {
-		if gorm.IsRecordNotFoundError(err) {
-			return
+	err = br.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lock(tx, id, version, &before); err != nil {
+			return err
 		}
-		return
-	}
 
-	newBranch, err = br.GetByID(id)
+		newBranch.Version = 0
+		if err := tx.Model(&models.Branch{}).Where("id = ?", id).UpdateColumns(newBranch).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.Branch{}).Where("id = ?", id).UpdateColumn("version", gorm.Expr("version + 1")).Error; err != nil {
+			return err
+		}
+
+		// An update without a timezone keeps the branch's
+		timezone := newBranch.Timezone
+		if timezone == "" {
+			timezone = before.Timezone
+		}
+
+		if err := followTimezone(tx, id, before.Timezone, timezone, actor); err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.Branch{}).Where("id = ?", id).Preload("MealPlans").Preload("BranchLocations").First(&branch).Error; err != nil {
+			return err
+		}
+
+		return outbox.Record(tx, actor, events.BranchUpdated{Before: before, After: branch})
+	})
+	if gorm.IsRecordNotFoundError(err) {
+		err = utils.ErrBranchNotFound
+	}
 	if err != nil {
+		err = utils.TranslateDBError(err)
 		return
 	}
 
-	res = newBranch
+	res = branch
 
 	// ToDo: Redis cache update
 
 	return
 }
 
-func (br *branchRepository) Delete(id uuid.UUID) (err error) {
-	if err = br.Db.Model(&models.Branch{}).Where("id = ?", id).Find(&models.Branch{}).Delete(&models.Branch{}).Error; err != nil {
-		if gorm.IsRecordNotFoundError(err) {
-			return
+// followTimezone moves the meal plans of the branch held in its timezone
+// from before to after, so their sessions keep the local times of day they
+// have at the branch. Meal plans held in another timezone stay in it.
+func followTimezone(tx *gorm.DB, id uuid.UUID, before string, after string, actor string) error {
+	if before == after {
+		return nil
+	}
+
+	plans := []models.MealPlan{}
+	if err := tx.Set("gorm:query_option", "FOR UPDATE").
+		Where("timezone = ? AND id IN (SELECT meal_plan_id FROM branch_meal_plans WHERE branch_id = ? AND deleted_at IS NULL)", before, id).
+		Find(&plans).Error; err != nil {
+		return err
+	}
+
+	for _, plan := range plans {
+		if err := tx.Model(&models.MealPlan{}).Where("id = ?", plan.ID).UpdateColumns(map[string]interface{}{
+			"timezone":   after,
+			"updated_at": time.Now(),
+			"version":    gorm.Expr("version + 1"),
+		}).Error; err != nil {
+			return err
+		}
+
+		moved := models.MealPlan{}
+		if err := tx.Model(&models.MealPlan{}).Where("id = ?", plan.ID).Preload("Branches").First(&moved).Error; err != nil {
+			return err
+		}
+
+		if err := outbox.Record(tx, actor, events.MealPlanUpdated{Before: plan, After: moved}); err != nil {
+			return err
 		}
+	}
+
+	return nil
+}
+
+// Patch writes every field of the patched branch, zero values included, and
+// its location along with it.
+func (br *branchRepository) Patch(ctx context.Context, id uuid.UUID, version uint64, patch models.BranchPatch, actor string) (res models.Branch, err error) {
+	before := models.Branch{}
+	branch := models.Branch{}
+
+	err = br.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lock(tx, id, version, &before); err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.Branch{}).Where("id = ?", id).UpdateColumns(map[string]interface{}{
+			"branch_name":   patch.BranchName,
+			"opening_hours": patch.OpeningHours,
+			"timezone":      patch.Timezone,
+			"updated_at":    time.Now(),
+			"version":       gorm.Expr("version + 1"),
+		}).Error; err != nil {
+			return err
+		}
+
+		db := tx.Model(&models.BranchLocation{}).Where("branch_id = ?", id).UpdateColumns(map[string]interface{}{
+			"latitude":  patch.Locations.Latitude,
+			"longitude": patch.Locations.Longitude,
+		})
+		if db.Error != nil {
+			return db.Error
+		}
+
+		if db.RowsAffected == 0 {
+			location := models.BranchLocation{BranchID: id, Latitude: patch.Locations.Latitude, Longitude: patch.Locations.Longitude}
+			if err := tx.Create(&location).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := followTimezone(tx, id, before.Timezone, patch.Timezone, actor); err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.Branch{}).Where("id = ?", id).Preload("MealPlans").Preload("BranchLocations").First(&branch).Error; err != nil {
+			return err
+		}
+
+		return outbox.Record(tx, actor, events.BranchUpdated{Before: before, After: branch})
+	})
+	if gorm.IsRecordNotFoundError(err) {
+		err = utils.ErrBranchNotFound
+	}
+	if err != nil {
+		err = utils.TranslateDBError(err)
+		return
+	}
+
+	res = branch
+
+	return
+}
+
+// Delete soft deletes the branch together with its location and meal plan
+// links, stamping them with the same time so Restore can bring back exactly
+// the rows this delete removed.
+func (br *branchRepository) Delete(ctx context.Context, id uuid.UUID, version uint64, actor string) (err error) {
+	err = br.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		branch := models.Branch{}
+		now := time.Now()
+
+		if err := lock(tx, id, version, &branch); err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.Branch{}).Where("id = ?", id).UpdateColumns(map[string]interface{}{"deleted_at": now, "version": gorm.Expr("version + 1")}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.BranchLocation{}).Where("branch_id = ?", id).UpdateColumn("deleted_at", now).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.BranchMealPlan{}).Where("branch_id = ?", id).UpdateColumn("deleted_at", now).Error; err != nil {
+			return err
+		}
+
+		return outbox.Record(tx, actor, events.BranchDeleted{Branch: branch})
+	})
+	if gorm.IsRecordNotFoundError(err) {
+		err = utils.ErrBranchNotFound
+	}
+	if err != nil {
 		return
 	}
 
@@ -145,10 +417,60 @@ func (br *branchRepository) Delete(id uuid.UUID) (err error) {
 	return
 }
 
-func (br *branchRepository) SearchBranches(column string, query string, order string) (res *[]models.Branch, err error) {
+func (br *branchRepository) Restore(ctx context.Context, id uuid.UUID, actor string) (res models.Branch, err error) {
+	branch := models.Branch{}
+
+	err = br.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		deleted := models.Branch{}
+
+		if err := tx.Unscoped().Model(&models.Branch{}).Where("id = ? AND deleted_at IS NOT NULL", id).First(&deleted).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Model(&models.BranchLocation{}).Where("branch_id = ? AND deleted_at = ?", id, deleted.DeletedAt).UpdateColumn("deleted_at", nil).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Model(&models.BranchMealPlan{}).Where("branch_id = ? AND deleted_at = ?", id, deleted.DeletedAt).UpdateColumn("deleted_at", nil).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Model(&models.Branch{}).Where("id = ?", id).UpdateColumns(map[string]interface{}{"deleted_at": nil, "version": gorm.Expr("version + 1")}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.Branch{}).Where("id = ?", id).Preload("MealPlans").Preload("BranchLocations").First(&branch).Error; err != nil {
+			return err
+		}
+
+		return outbox.Record(tx, actor, events.BranchRestored{Branch: branch, DeletedAt: deleted.DeletedAt})
+	})
+	if gorm.IsRecordNotFoundError(err) {
+		err = utils.ErrDeletedBranchNotFound
+	}
+	if err != nil {
+		err = utils.TranslateDBError(err)
+		return
+	}
+
+	res = branch
+
+	return
+}
+
+// Purge hard deletes branches soft deleted before the given time. Their
+// locations and meal plan links go with them through ON DELETE CASCADE.
+func (br *branchRepository) Purge(ctx context.Context, before time.Time) (purged int64, err error) {
+	db := br.conn(ctx).Unscoped().Where("deleted_at < ?", before).Delete(&models.Branch{})
+	purged, err = db.RowsAffected, db.Error
+
+	return
+}
+
+func (br *branchRepository) SearchBranches(ctx context.Context, column string, query string, order string) (res *[]models.Branch, err error) {
 	branch := &[]models.Branch{}
 
-	if err = br.Db.Model(&models.Branch{}).Where(column+" ILIKE ?", "%"+query+"%").Order(order).Preload("MealPlans").Preload("BranchLocations").Find(&branch).Error; err != nil {
+	if err = br.conn(ctx).Model(&models.Branch{}).Where(column+" ILIKE ?", "%"+query+"%").Order(order).Preload("MealPlans").Preload("MealPlans.Translations").Preload("BranchLocations").Preload("Translations").Find(&branch).Error; err != nil {
 		return
 	}
 
@@ -156,3 +478,42 @@ func (br *branchRepository) SearchBranches(column string, query string, order st
 
 	return
 }
+
+// Translations returns the translations of the branch ordered by locale
+func (br *branchRepository) Translations(ctx context.Context, branchID uuid.UUID) (res []models.BranchTranslation, err error) {
+	res = []models.BranchTranslation{}
+	err = br.conn(ctx).Model(&models.BranchTranslation{}).Where("branch_id = ?", branchID).Order("locale").Find(&res).Error
+
+	return
+}
+
+// StoreTranslation creates the translation of the branch in its locale, or
+// replaces the existing one
+func (br *branchRepository) StoreTranslation(ctx context.Context, t *models.BranchTranslation) (res *models.BranchTranslation, err error) {
+	db := br.conn(ctx)
+
+	if err = db.Set("gorm:insert_option", "ON CONFLICT (branch_id, locale) DO UPDATE SET branch_name = EXCLUDED.branch_name, updated_at = EXCLUDED.updated_at").Create(t).Error; err != nil {
+		err = utils.TranslateDBError(err)
+		return
+	}
+
+	// Reads the row back for the created_at of a replaced translation
+	res = &models.BranchTranslation{}
+	err = db.Where("branch_id = ? AND locale = ?", t.BranchID, t.Locale).First(res).Error
+
+	return
+}
+
+// DeleteTranslation deletes the translation of the branch in locale
+func (br *branchRepository) DeleteTranslation(ctx context.Context, branchID uuid.UUID, locale string) error {
+	db := br.conn(ctx).Where("branch_id = ? AND locale = ?", branchID, locale).Delete(&models.BranchTranslation{})
+	if db.Error != nil {
+		return db.Error
+	}
+
+	if db.RowsAffected == 0 {
+		return utils.ErrTranslationNotFound
+	}
+
+	return nil
+}
@@ -1,25 +1,96 @@
 package usecase
 
 import (
+	"context"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/iamaul/fatbellies/app/branch"
 	"github.com/iamaul/fatbellies/app/models"
+	"github.com/iamaul/fatbellies/app/uow"
+	"github.com/iamaul/fatbellies/utils"
+)
+
+var (
+	// orders are the orders Fetch and SearchBranches accept
+	orders = utils.SortOrders("created_at", "updated_at", "branch_name", "opening_hours")
+
+	// searchColumns are the columns SearchBranches accepts and what each
+	// matches against
+	searchColumns = map[string]string{
+		"branch_name": "branch_name",
+		"timezone":    "timezone",
+	}
 )
 
 type branchUsecase struct {
 	branchRepo     branch.Repository
+	unitOfWork     uow.UnitOfWork
 	contextTimeout time.Duration
 }
 
-func NewBranchUsecase(br branch.Repository) branch.Usecase {
+func NewBranchUsecase(br branch.Repository, uw uow.UnitOfWork, timeout time.Duration) branch.Usecase {
 	return &branchUsecase{
-		branchRepo: br,
+		branchRepo:     br,
+		unitOfWork:     uw,
+		contextTimeout: timeout,
+	}
+}
+
+func (bu *branchUsecase) Fetch(ctx context.Context, limit int64, offset int64, order string, includeDeleted bool) (*[]models.Branch, error) {
+	ctx, cancel := context.WithTimeout(ctx, bu.contextTimeout)
+	defer cancel()
+
+	if limit == 0 {
+		limit = 10
+	}
+
+	if order == "" {
+		order = "created_at desc"
+	}
+
+	order, err := utils.SortOrder(order, orders)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := bu.branchRepo.Fetch(ctx, limit, offset, order, includeDeleted)
+
+	if res != nil {
+		for i := range *res {
+			localizeBranch(ctx, &(*res)[i])
+		}
 	}
+
+	return res, err
+}
+
+func (bu *branchUsecase) GetByID(ctx context.Context, id uuid.UUID) (models.Branch, error) {
+	ctx, cancel := context.WithTimeout(ctx, bu.contextTimeout)
+	defer cancel()
+
+	res, err := bu.branchRepo.GetByID(ctx, id)
+
+	localizeBranch(ctx, &res)
+
+	return res, err
 }
 
-func (bu *branchUsecase) Fetch(limit int64, offset int64, order string) (*[]models.Branch, error) {
+func (bu *branchUsecase) GetByName(ctx context.Context, name string) (models.Branch, error) {
+	ctx, cancel := context.WithTimeout(ctx, bu.contextTimeout)
+	defer cancel()
+
+	res, err := bu.branchRepo.GetByName(ctx, name)
+
+	localizeBranch(ctx, &res)
+
+	return res, err
+}
+
+func (bu *branchUsecase) List(ctx context.Context, limit int64, offset int64, order string, query string) ([]models.Branch, error) {
+	ctx, cancel := context.WithTimeout(ctx, bu.contextTimeout)
+	defer cancel()
+
 	if limit == 0 {
 		limit = 10
 	}
@@ -28,59 +99,412 @@ func (bu *branchUsecase) Fetch(limit int64, offset int64, order string) (*[]mode
 		order = "created_at desc"
 	}
 
-	res, err := bu.branchRepo.Fetch(limit, offset, order)
+	res, err := bu.branchRepo.List(ctx, limit, offset, order, query)
+
+	for i := range res {
+		localizeBranch(ctx, &res[i])
+	}
 
 	return res, err
 }
 
-func (bu *branchUsecase) GetByID(id uuid.UUID) (models.Branch, error) {
-	res, err := bu.branchRepo.GetByID(id)
+func (bu *branchUsecase) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Branch, error) {
+	ctx, cancel := context.WithTimeout(ctx, bu.contextTimeout)
+	defer cancel()
+
+	res, err := bu.branchRepo.GetByIDs(ctx, ids)
+
+	for i := range res {
+		localizeBranch(ctx, &res[i])
+	}
 
 	return res, err
 }
 
-func (bu *branchUsecase) GetByName(name string) (models.Branch, error) {
-	res, err := bu.branchRepo.GetByName(name)
+func (bu *branchUsecase) LocationsByBranch(ctx context.Context, branchIDs []uuid.UUID) ([]models.BranchLocation, error) {
+	ctx, cancel := context.WithTimeout(ctx, bu.contextTimeout)
+	defer cancel()
+
+	res, err := bu.branchRepo.LocationsByBranch(ctx, branchIDs)
 
 	return res, err
 }
 
-func (bu *branchUsecase) FindNearestLocation(lat float64, long float64) (*[]models.BranchLocation, error) {
-	res, err := bu.branchRepo.FindNearestLocation(lat, long)
+func (bu *branchUsecase) MealPlanLinks(ctx context.Context, branchIDs []uuid.UUID) ([]models.BranchMealPlan, error) {
+	ctx, cancel := context.WithTimeout(ctx, bu.contextTimeout)
+	defer cancel()
+
+	res, err := bu.branchRepo.MealPlanLinks(ctx, branchIDs)
 
 	return res, err
 }
 
-func (bu *branchUsecase) Store(branch *models.Branch) (*models.Branch, error) {
-	res, err := bu.branchRepo.Store(branch)
+func (bu *branchUsecase) FindNearestLocation(ctx context.Context, lat float64, long float64) (*[]models.BranchLocation, error) {
+	ctx, cancel := context.WithTimeout(ctx, bu.contextTimeout)
+	defer cancel()
+
+	res, err := bu.branchRepo.FindNearestLocation(ctx, lat, long)
 
 	return res, err
 }
 
-func (bu *branchUsecase) StoreMealPlan(mealPlan *models.BranchMealPlan) (err error) {
-	err = bu.branchRepo.StoreMealPlan(mealPlan)
+// Store creates the branch, its location and its links to MealPlanIDs in one
+// unit of work, so an unknown meal plan leaves no half created branch behind.
+func (bu *branchUsecase) Store(ctx context.Context, branch *models.Branch, actor string) (*models.Branch, error) {
+	ctx, cancel := context.WithTimeout(ctx, bu.contextTimeout)
+	defer cancel()
+
+	res := models.Branch{}
+
+	if branch.Timezone == "" {
+		branch.Timezone = utils.DefaultTimezone
+	}
+
+	err := bu.unitOfWork.Do(ctx, func(r uow.Repositories) error {
+		// Links are only created from MealPlanIDs, never by saving associations
+		branch.MealPlans = nil
+
+		created, err := r.Branches.Store(ctx, branch, actor)
+		if err != nil {
+			return err
+		}
+
+		linked := make(map[uuid.UUID]bool)
+		for _, mealPlanID := range branch.MealPlanIDs {
+			if linked[mealPlanID] {
+				continue
+			}
+			linked[mealPlanID] = true
+
+			if err := r.Branches.StoreMealPlan(ctx, &models.BranchMealPlan{BranchID: created.ID, MealPlanID: mealPlanID}, actor); err != nil {
+				return err
+			}
+		}
+
+		res, err = r.Branches.GetByID(ctx, created.ID)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (bu *branchUsecase) StoreMealPlan(ctx context.Context, mealPlan *models.BranchMealPlan, actor string) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, bu.contextTimeout)
+	defer cancel()
+
+	err = bu.branchRepo.StoreMealPlan(ctx, mealPlan, actor)
 
 	return err
 }
 
-func (bu *branchUsecase) Update(id uuid.UUID, branch models.Branch) (models.Branch, error) {
-	res, err := bu.branchRepo.Update(id, branch)
+// SetMealPlans replaces the meal plans linked to the branch with mealPlanIDs
+func (bu *branchUsecase) SetMealPlans(ctx context.Context, branchID uuid.UUID, mealPlanIDs []uuid.UUID, actor string) (res models.MealPlanLinkChanges, err error) {
+	ctx, cancel := context.WithTimeout(ctx, bu.contextTimeout)
+	defer cancel()
+
+	err = bu.unitOfWork.Do(ctx, func(r uow.Repositories) error {
+		res, err = relink(ctx, r, branchID, func([]uuid.UUID) []uuid.UUID {
+			return mealPlanIDs
+		}, actor)
+		return err
+	})
+
+	return
+}
+
+// AddMealPlans links mealPlanIDs to the branch, skipping those already linked
+func (bu *branchUsecase) AddMealPlans(ctx context.Context, branchID uuid.UUID, mealPlanIDs []uuid.UUID, actor string) (res models.MealPlanLinkChanges, err error) {
+	ctx, cancel := context.WithTimeout(ctx, bu.contextTimeout)
+	defer cancel()
+
+	err = bu.unitOfWork.Do(ctx, func(r uow.Repositories) error {
+		res, err = relink(ctx, r, branchID, func(current []uuid.UUID) []uuid.UUID {
+			return append(current, mealPlanIDs...)
+		}, actor)
+		return err
+	})
+
+	return
+}
+
+// RemoveMealPlans unlinks mealPlanIDs from the branch, skipping those not linked
+func (bu *branchUsecase) RemoveMealPlans(ctx context.Context, branchID uuid.UUID, mealPlanIDs []uuid.UUID, actor string) (res models.MealPlanLinkChanges, err error) {
+	ctx, cancel := context.WithTimeout(ctx, bu.contextTimeout)
+	defer cancel()
+
+	removed := make(map[uuid.UUID]bool)
+	for _, id := range mealPlanIDs {
+		removed[id] = true
+	}
+
+	err = bu.unitOfWork.Do(ctx, func(r uow.Repositories) error {
+		res, err = relink(ctx, r, branchID, func(current []uuid.UUID) []uuid.UUID {
+			kept := make([]uuid.UUID, 0, len(current))
+			for _, id := range current {
+				if !removed[id] {
+					kept = append(kept, id)
+				}
+			}
+			return kept
+		}, actor)
+		return err
+	})
+
+	return
+}
+
+// CopyMealPlans gives every branch in branchIDs the meal plans of the source
+// branch, replacing what they were linked to.
+func (bu *branchUsecase) CopyMealPlans(ctx context.Context, sourceID uuid.UUID, branchIDs []uuid.UUID, actor string) (res []models.MealPlanLinkChanges, err error) {
+	ctx, cancel := context.WithTimeout(ctx, bu.contextTimeout)
+	defer cancel()
+
+	err = bu.unitOfWork.Do(ctx, func(r uow.Repositories) error {
+		res = nil
+
+		if _, err := r.Branches.GetByID(ctx, sourceID); err != nil {
+			return err
+		}
+
+		lineup, err := r.Branches.MealPlanIDs(ctx, sourceID)
+		if err != nil {
+			return err
+		}
+
+		copied := make(map[uuid.UUID]bool)
+		for _, branchID := range branchIDs {
+			if branchID == sourceID || copied[branchID] {
+				continue
+			}
+			copied[branchID] = true
+
+			changes, err := relink(ctx, r, branchID, func([]uuid.UUID) []uuid.UUID {
+				return lineup
+			}, actor)
+			if err != nil {
+				return err
+			}
+
+			res = append(res, changes)
+		}
+
+		return nil
+	})
+
+	return
+}
+
+// relink moves the branch from the meal plans it is linked to onto the ones
+// returned by want, and reports the links it made and removed.
+func relink(ctx context.Context, r uow.Repositories, branchID uuid.UUID, want func(current []uuid.UUID) []uuid.UUID, actor string) (models.MealPlanLinkChanges, error) {
+	res := models.MealPlanLinkChanges{
+		BranchID:    branchID,
+		Linked:      []uuid.UUID{},
+		Unlinked:    []uuid.UUID{},
+		MealPlanIDs: []uuid.UUID{},
+	}
+
+	if _, err := r.Branches.GetByID(ctx, branchID); err != nil {
+		return res, err
+	}
+
+	current, err := r.Branches.MealPlanIDs(ctx, branchID)
+	if err != nil {
+		return res, err
+	}
+
+	linked := make(map[uuid.UUID]bool)
+	for _, id := range current {
+		linked[id] = true
+	}
+
+	wanted := make(map[uuid.UUID]bool)
+	for _, id := range want(append([]uuid.UUID(nil), current...)) {
+		if wanted[id] {
+			continue
+		}
+		wanted[id] = true
+		res.MealPlanIDs = append(res.MealPlanIDs, id)
+
+		if linked[id] {
+			continue
+		}
+
+		if err := r.Branches.StoreMealPlan(ctx, &models.BranchMealPlan{BranchID: branchID, MealPlanID: id}, actor); err != nil {
+			return res, err
+		}
+		res.Linked = append(res.Linked, id)
+	}
+
+	for _, id := range current {
+		if wanted[id] {
+			continue
+		}
+
+		if err := r.Branches.DeleteMealPlan(ctx, &models.BranchMealPlan{BranchID: branchID, MealPlanID: id}, actor); err != nil {
+			return res, err
+		}
+		res.Unlinked = append(res.Unlinked, id)
+	}
+
+	return res, nil
+}
+
+func (bu *branchUsecase) Update(ctx context.Context, id uuid.UUID, version uint64, branch models.Branch, actor string) (models.Branch, error) {
+	ctx, cancel := context.WithTimeout(ctx, bu.contextTimeout)
+	defer cancel()
+
+	res, err := bu.branchRepo.Update(ctx, id, version, branch, actor)
 
 	return res, err
 }
 
-func (bu *branchUsecase) Delete(id uuid.UUID) (err error) {
-	err = bu.branchRepo.Delete(id)
+// Patch applies a JSON merge patch to the branch. Only the fields the patch
+// touches are validated, and a null member clears its field.
+func (bu *branchUsecase) Patch(ctx context.Context, id uuid.UUID, version uint64, patch []byte, actor string) (res models.Branch, err error) {
+	ctx, cancel := context.WithTimeout(ctx, bu.contextTimeout)
+	defer cancel()
+
+	err = bu.unitOfWork.Do(ctx, func(r uow.Repositories) error {
+		current, err := r.Branches.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		doc := models.NewBranchPatch(current)
+
+		touched, err := utils.MergePatch(&doc, patch)
+		if err != nil {
+			return err
+		}
+
+		if err := utils.ValidatePatch(&doc, touched); err != nil {
+			return err
+		}
+
+		res, err = r.Branches.Patch(ctx, id, version, doc, actor)
+
+		return err
+	})
+
+	return
+}
+
+func (bu *branchUsecase) Delete(ctx context.Context, id uuid.UUID, version uint64, actor string) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, bu.contextTimeout)
+	defer cancel()
+
+	err = bu.branchRepo.Delete(ctx, id, version, actor)
 
 	return err
 }
 
-func (bu *branchUsecase) SearchBranches(column string, label string, order string) (*[]models.Branch, error) {
+func (bu *branchUsecase) Restore(ctx context.Context, id uuid.UUID, actor string) (models.Branch, error) {
+	ctx, cancel := context.WithTimeout(ctx, bu.contextTimeout)
+	defer cancel()
+
+	res, err := bu.branchRepo.Restore(ctx, id, actor)
+
+	return res, err
+}
+
+func (bu *branchUsecase) Purge(ctx context.Context, retentionDays int) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, bu.contextTimeout)
+	defer cancel()
+
+	res, err := bu.branchRepo.Purge(ctx, time.Now().AddDate(0, 0, -retentionDays))
+
+	return res, err
+}
+
+func (bu *branchUsecase) SearchBranches(ctx context.Context, column string, label string, order string) (*[]models.Branch, error) {
+	ctx, cancel := context.WithTimeout(ctx, bu.contextTimeout)
+	defer cancel()
+
 	if order == "" {
 		order = "created_at desc"
 	}
 
-	res, err := bu.branchRepo.SearchBranches(column, label, order)
+	column, err := utils.SearchColumn(column, searchColumns)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err = utils.SortOrder(order, orders)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := bu.branchRepo.SearchBranches(ctx, column, label, order)
+
+	if res != nil {
+		for i := range *res {
+			localizeBranch(ctx, &(*res)[i])
+		}
+	}
 
 	return res, err
 }
+
+func (bu *branchUsecase) Translations(ctx context.Context, branchID uuid.UUID) ([]models.BranchTranslation, error) {
+	ctx, cancel := context.WithTimeout(ctx, bu.contextTimeout)
+	defer cancel()
+
+	if _, err := bu.branchRepo.GetByID(ctx, branchID); err != nil {
+		return nil, err
+	}
+
+	res, err := bu.branchRepo.Translations(ctx, branchID)
+
+	return res, err
+}
+
+// StoreTranslation creates or replaces the translation of the branch in the
+// locale of translation. The default locale is the branch's own content.
+func (bu *branchUsecase) StoreTranslation(ctx context.Context, translation *models.BranchTranslation) (*models.BranchTranslation, error) {
+	ctx, cancel := context.WithTimeout(ctx, bu.contextTimeout)
+	defer cancel()
+
+	locale, err := utils.TranslationLocale(translation.Locale)
+	if err != nil {
+		return nil, err
+	}
+	translation.Locale = locale
+
+	if _, err := bu.branchRepo.GetByID(ctx, translation.BranchID); err != nil {
+		return nil, err
+	}
+
+	res, err := bu.branchRepo.StoreTranslation(ctx, translation)
+
+	return res, err
+}
+
+func (bu *branchUsecase) DeleteTranslation(ctx context.Context, branchID uuid.UUID, locale string) error {
+	ctx, cancel := context.WithTimeout(ctx, bu.contextTimeout)
+	defer cancel()
+
+	locale, err := utils.TranslationLocale(locale)
+	if err != nil {
+		return err
+	}
+
+	if _, err := bu.branchRepo.GetByID(ctx, branchID); err != nil {
+		return err
+	}
+
+	return bu.branchRepo.DeleteTranslation(ctx, branchID, locale)
+}
+
+// localizeBranch translates the branch into the language negotiated for the
+// request. Requests that negotiated none, such as gRPC calls, get the
+// branch as stored.
+func localizeBranch(ctx context.Context, branch *models.Branch) {
+	if locales := utils.LocalesFrom(ctx); locales != nil {
+		branch.Localize(locales, utils.DefaultLocale)
+	}
+}
@@ -0,0 +1,237 @@
+package codec
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Format is a file format supported by bulk import and export
+type Format string
+
+const (
+	CSV  Format = "csv"
+	JSON Format = "json"
+	XLSX Format = "xlsx"
+)
+
+var ContentTypes = map[Format]string{
+	CSV:  "text/csv",
+	JSON: "application/json",
+	XLSX: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+// Record is one row of a file keyed by its column header
+type Record map[string]string
+
+// ParseFormat returns the format named by s, which may also be a file name
+// whose extension names the format.
+func ParseFormat(s string) (Format, error) {
+	name := strings.ToLower(strings.TrimPrefix(filepath.Ext(s), "."))
+	if name == "" {
+		name = strings.ToLower(s)
+	}
+
+	switch Format(name) {
+	case CSV, JSON, XLSX:
+		return Format(name), nil
+	}
+
+	return "", fmt.Errorf("unsupported format %q, expected csv, json or xlsx", s)
+}
+
+// Read decodes every record of r. Header names are trimmed and lower cased;
+// JSON input must be an array of objects whose values are strings or numbers.
+func Read(format Format, r io.Reader) ([]Record, error) {
+	switch format {
+	case CSV:
+		rows, err := csv.NewReader(r).ReadAll()
+		if err != nil {
+			return nil, err
+		}
+		return fromRows(rows), nil
+	case XLSX:
+		f, err := excelize.OpenReader(r)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		rows, err := f.GetRows(f.GetSheetName(0))
+		if err != nil {
+			return nil, err
+		}
+		return fromRows(rows), nil
+	case JSON:
+		var objects []map[string]interface{}
+		if err := json.NewDecoder(r).Decode(&objects); err != nil {
+			return nil, err
+		}
+
+		records := make([]Record, 0, len(objects))
+		for _, object := range objects {
+			record := Record{}
+			for key, value := range object {
+				if value != nil {
+					record[strings.ToLower(strings.TrimSpace(key))] = strings.TrimSpace(fmt.Sprint(value))
+				}
+			}
+			records = append(records, record)
+		}
+		return records, nil
+	}
+
+	return nil, fmt.Errorf("unsupported format %q", format)
+}
+
+func fromRows(rows [][]string) []Record {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	header := rows[0]
+	for i := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(header[i]))
+	}
+
+	records := make([]Record, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		record := Record{}
+		for i, value := range row {
+			if i < len(header) && header[i] != "" {
+				record[header[i]] = strings.TrimSpace(value)
+			}
+		}
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// Writer encodes records one at a time, so exports never hold the whole
+// result in memory.
+type Writer interface {
+	Write(values []string) error
+	Close() error
+}
+
+// NewWriter returns a Writer for format. The columns are written first as
+// the CSV and XLSX header and used as the JSON object keys.
+func NewWriter(format Format, w io.Writer, columns []string) (Writer, error) {
+	switch format {
+	case CSV:
+		cw := &csvWriter{w: csv.NewWriter(w)}
+		return cw, cw.Write(columns)
+	case JSON:
+		return &jsonWriter{w: w, columns: columns}, nil
+	case XLSX:
+		f := excelize.NewFile()
+		sw, err := f.NewStreamWriter(f.GetSheetName(0))
+		if err != nil {
+			return nil, err
+		}
+		xw := &xlsxWriter{w: w, f: f, sw: sw}
+		return xw, xw.Write(columns)
+	}
+
+	return nil, fmt.Errorf("unsupported format %q", format)
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (cw *csvWriter) Write(values []string) error {
+	if err := cw.w.Write(values); err != nil {
+		return err
+	}
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+func (cw *csvWriter) Close() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+type jsonWriter struct {
+	w       io.Writer
+	columns []string
+	count   int
+}
+
+func (jw *jsonWriter) Write(values []string) error {
+	if len(values) != len(jw.columns) {
+		return errors.New("value count does not match the columns")
+	}
+
+	object := make(map[string]string, len(values))
+	for i, value := range values {
+		object[jw.columns[i]] = value
+	}
+
+	raw, err := json.Marshal(object)
+	if err != nil {
+		return err
+	}
+
+	sep := ",\n"
+	if jw.count == 0 {
+		sep = "[\n"
+	}
+	jw.count++
+
+	_, err = io.WriteString(jw.w, sep+string(raw))
+	return err
+}
+
+func (jw *jsonWriter) Close() error {
+	end := "\n]\n"
+	if jw.count == 0 {
+		end = "[]\n"
+	}
+
+	_, err := io.WriteString(jw.w, end)
+	return err
+}
+
+// xlsxWriter streams rows into a temporary sheet and writes the workbook out
+// on Close, the zip container not allowing anything earlier.
+type xlsxWriter struct {
+	w   io.Writer
+	f   *excelize.File
+	sw  *excelize.StreamWriter
+	row int
+}
+
+func (xw *xlsxWriter) Write(values []string) error {
+	xw.row++
+
+	cell, err := excelize.CoordinatesToCellName(1, xw.row)
+	if err != nil {
+		return err
+	}
+
+	row := make([]interface{}, len(values))
+	for i, value := range values {
+		row[i] = value
+	}
+
+	return xw.sw.SetRow(cell, row)
+}
+
+func (xw *xlsxWriter) Close() error {
+	defer xw.f.Close()
+
+	if err := xw.sw.Flush(); err != nil {
+		return err
+	}
+
+	return xw.f.Write(xw.w)
+}
@@ -0,0 +1,203 @@
+package http
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/iamaul/fatbellies/app/bulk"
+	"github.com/iamaul/fatbellies/app/bulk/codec"
+	"github.com/iamaul/fatbellies/app/models"
+	"github.com/iamaul/fatbellies/utils"
+	"github.com/labstack/echo/v4"
+)
+
+type BulkHandler struct {
+	Bulkcase bulk.Usecase
+}
+
+func NewBulkHandler(e *echo.Echo, bu bulk.Usecase) {
+	handler := &BulkHandler{
+		Bulkcase: bu,
+	}
+
+	v1 := e.Group("/api/v1")
+	v1.POST("/branches/import", handler.ImportBranches)
+	v1.GET("/branches/export", handler.ExportBranches)
+	v1.POST("/mealplans/import", handler.ImportMealPlans)
+	v1.GET("/mealplans/export", handler.ExportMealPlans)
+
+	// Deprecated aliases of the v1 routes, served until utils.LegacySunset
+	g := e.Group("/api")
+	g.POST("/import/branches", handler.ImportBranches, utils.Deprecated("/api/v1/branches/import"))
+	g.POST("/import/mealplans", handler.ImportMealPlans, utils.Deprecated("/api/v1/mealplans/import"))
+	g.GET("/export/branches", handler.ExportBranches, utils.Deprecated("/api/v1/branches/export"))
+	g.GET("/export/mealplans", handler.ExportMealPlans, utils.Deprecated("/api/v1/mealplans/export"))
+}
+
+// importFile returns the uploaded file, taken from the "file" form field or
+// else the raw request body, with its format. The format query parameter
+// wins over the file name.
+func importFile(c echo.Context) (codec.Format, io.ReadCloser, error) {
+	name := c.QueryParam("format")
+
+	var body io.ReadCloser = c.Request().Body
+
+	if file, err := c.FormFile("file"); err == nil {
+		if name == "" {
+			name = file.Filename
+		}
+
+		body, err = file.Open()
+		if err != nil {
+			return "", nil, utils.ErrImportFile.Wrap(err)
+		}
+	}
+
+	format, err := codec.ParseFormat(name)
+	if err != nil {
+		body.Close()
+		return "", nil, utils.InvalidField("format", "oneof", err.Error())
+	}
+
+	return format, body, nil
+}
+
+func importResponse(c echo.Context, report *models.ImportReport) error {
+	if report.Invalid > 0 {
+		return c.JSON(http.StatusUnprocessableEntity, &utils.ResponseJSON{
+			Code:      http.StatusUnprocessableEntity,
+			Result:    report,
+			Message:   "Import has invalid rows, nothing was written",
+			ErrorCode: "import_invalid",
+			Success:   false,
+		})
+	}
+
+	message := "Import applied successfully"
+	if report.DryRun {
+		message = "Import validated, nothing was written"
+	}
+
+	return c.JSON(http.StatusOK, &utils.ResponseJSON{
+		Code:    http.StatusOK,
+		Result:  report,
+		Message: message,
+		Success: true,
+	})
+}
+
+// @Summary Import branches
+// @Description Upsert branches by name from a CSV, JSON or XLSX file with the columns branch_name, opening_hours, latitude and longitude. Nothing is written when a row is invalid.
+// @Tags Bulk
+// @Accept  multipart/form-data
+// @Produce  json
+// @Param file formData file true "Import file"
+// @Param format query string false "csv, json or xlsx, taken from the file name by default"
+// @Param dry_run query boolean false "validate and report without writing"
+// @Success 200 {object} models.ImportReport
+// @Router /branches/import [post]
+func (bh *BulkHandler) ImportBranches(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	format, file, err := importFile(c)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	dryRun, _ := strconv.ParseBool(c.QueryParam("dry_run"))
+
+	report, err := bh.Bulkcase.ImportBranches(ctx, format, file, dryRun, utils.Actor(c))
+	if err != nil {
+		return err
+	}
+
+	return importResponse(c, report)
+}
+
+// @Summary Import meal plans
+// @Description Upsert meal plans by name from a CSV, JSON or XLSX file with the columns meal_plan_name, max_capacity, price, day, start_time, end_time and timezone. day is a weekday and the times are HH:MM local times of day in timezone, an IANA timezone defaulting to the one the meal plan is in; an end_time not after start_time ends the following day. Nothing is written when a row is invalid.
+// @Tags Bulk
+// @Accept  multipart/form-data
+// @Produce  json
+// @Param file formData file true "Import file"
+// @Param format query string false "csv, json or xlsx, taken from the file name by default"
+// @Param dry_run query boolean false "validate and report without writing"
+// @Success 200 {object} models.ImportReport
+// @Router /mealplans/import [post]
+func (bh *BulkHandler) ImportMealPlans(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	format, file, err := importFile(c)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	dryRun, _ := strconv.ParseBool(c.QueryParam("dry_run"))
+
+	report, err := bh.Bulkcase.ImportMealPlans(ctx, format, file, dryRun, utils.Actor(c))
+	if err != nil {
+		return err
+	}
+
+	return importResponse(c, report)
+}
+
+// startExport parses the format query parameter, csv by default, and sends
+// the headers of the download.
+func startExport(c echo.Context, name string) (codec.Format, error) {
+	query := c.QueryParam("format")
+	if query == "" {
+		query = string(codec.CSV)
+	}
+
+	format, err := codec.ParseFormat(query)
+	if err != nil {
+		return "", utils.InvalidField("format", "oneof", err.Error())
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, codec.ContentTypes[format])
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", name+"."+string(format)))
+	c.Response().WriteHeader(http.StatusOK)
+
+	return format, nil
+}
+
+// @Summary Export branches
+// @Description Download every branch as CSV, JSON or XLSX, with the columns the import reads
+// @Tags Bulk
+// @Produce  octet-stream
+// @Param format query string false "csv (default), json or xlsx"
+// @Success 200 {file} file
+// @Router /branches/export [get]
+func (bh *BulkHandler) ExportBranches(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	format, err := startExport(c, "branches")
+	if err != nil {
+		return err
+	}
+
+	return bh.Bulkcase.ExportBranches(ctx, format, c.Response())
+}
+
+// @Summary Export meal plans
+// @Description Download every meal plan as CSV, JSON or XLSX, with the columns the import reads
+// @Tags Bulk
+// @Produce  octet-stream
+// @Param format query string false "csv (default), json or xlsx"
+// @Success 200 {file} file
+// @Router /mealplans/export [get]
+func (bh *BulkHandler) ExportMealPlans(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	format, err := startExport(c, "mealplans")
+	if err != nil {
+		return err
+	}
+
+	return bh.Bulkcase.ExportMealPlans(ctx, format, c.Response())
+}
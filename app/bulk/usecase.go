@@ -0,0 +1,17 @@
+package bulk
+
+import (
+	"context"
+	"io"
+
+	"github.com/iamaul/fatbellies/app/bulk/codec"
+	"github.com/iamaul/fatbellies/app/models"
+)
+
+// Usecase represent the bulk import and export usecases
+type Usecase interface {
+	ImportBranches(ctx context.Context, format codec.Format, r io.Reader, dryRun bool, actor string) (*models.ImportReport, error)
+	ImportMealPlans(ctx context.Context, format codec.Format, r io.Reader, dryRun bool, actor string) (*models.ImportReport, error)
+	ExportBranches(ctx context.Context, format codec.Format, w io.Writer) error
+	ExportMealPlans(ctx context.Context, format codec.Format, w io.Writer) error
+}
@@ -0,0 +1,434 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/iamaul/fatbellies/app/branch"
+	"github.com/iamaul/fatbellies/app/bulk"
+	"github.com/iamaul/fatbellies/app/bulk/codec"
+	mealPlan "github.com/iamaul/fatbellies/app/meal_plan"
+	mpu "github.com/iamaul/fatbellies/app/meal_plan/usecase"
+	"github.com/iamaul/fatbellies/app/models"
+	"github.com/iamaul/fatbellies/app/uow"
+	"github.com/iamaul/fatbellies/utils"
+)
+
+const exportPageSize = 500
+
+var (
+	branchColumns   = []string{"branch_name", "opening_hours", "latitude", "longitude"}
+	mealPlanColumns = []string{"meal_plan_name", "max_capacity", "price", "day", "start_time", "end_time", "timezone"}
+)
+
+type bulkUsecase struct {
+	branchRepo     branch.Repository
+	mealPlanRepo   mealPlan.Repository
+	unitOfWork     uow.UnitOfWork
+	contextTimeout time.Duration
+}
+
+func NewBulkUsecase(br branch.Repository, mpr mealPlan.Repository, uw uow.UnitOfWork, timeout time.Duration) bulk.Usecase {
+	return &bulkUsecase{
+		branchRepo:     br,
+		mealPlanRepo:   mpr,
+		unitOfWork:     uw,
+		contextTimeout: timeout,
+	}
+}
+
+// ImportBranches upserts branches by name. Rows are validated with the same
+// rules as the API, and the import is written in one unit of work only when
+// every row is valid and dryRun is not set.
+func (u *bulkUsecase) ImportBranches(ctx context.Context, format codec.Format, r io.Reader, dryRun bool, actor string) (*models.ImportReport, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	records, err := codec.Read(format, r)
+	if err != nil {
+		return nil, utils.ErrImportFile.Wrap(err)
+	}
+
+	branches := make([]models.Branch, len(records))
+	report := newReport(len(records), dryRun)
+	for i, record := range records {
+		branches[i], report.Rows[i].Errors = parseBranch(record)
+		report.Rows[i].Name = branches[i].BranchName
+	}
+
+	err = u.upsert(ctx, report, func(r uow.Repositories, i int, apply bool) (string, error) {
+		existing, err := r.Branches.GetByName(ctx, branches[i].BranchName)
+		if errors.Is(err, utils.ErrBranchNotFound) {
+			if apply {
+				_, err = r.Branches.Store(ctx, &branches[i], actor)
+			}
+			return models.ImportCreate, err
+		}
+		if err != nil {
+			return "", err
+		}
+
+		// Patch writes every column, zero values and the location included,
+		// and keeps what the file has no column for
+		if apply {
+			patch := models.NewBranchPatch(existing)
+			patch.BranchName = branches[i].BranchName
+			patch.OpeningHours = branches[i].OpeningHours
+			patch.Locations.Latitude = branches[i].BranchLocations.Latitude
+			patch.Locations.Longitude = branches[i].BranchLocations.Longitude
+
+			_, err = r.Branches.Patch(ctx, existing.ID, 0, patch, actor)
+		}
+		return models.ImportUpdate, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// ImportMealPlans upserts meal plans by name, like ImportBranches. Sessions
+// are given as the weekday and local times of day, in the row's timezone or
+// else the one the meal plan is in.
+func (u *bulkUsecase) ImportMealPlans(ctx context.Context, format codec.Format, r io.Reader, dryRun bool, actor string) (*models.ImportReport, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	records, err := codec.Read(format, r)
+	if err != nil {
+		return nil, utils.ErrImportFile.Wrap(err)
+	}
+
+	plans := make([]models.MealPlan, len(records))
+	report := newReport(len(records), dryRun)
+	for i, record := range records {
+		plans[i], report.Rows[i].Errors = parseMealPlan(record)
+		report.Rows[i].Name = plans[i].MealPlanName
+	}
+
+	err = u.upsert(ctx, report, func(r uow.Repositories, i int, apply bool) (string, error) {
+		plan := plans[i]
+
+		existing, err := r.MealPlans.GetByName(ctx, plan.MealPlanName)
+		if errors.Is(err, utils.ErrMealPlanNotFound) {
+			if apply {
+				if err := mpu.Schedule(ctx, r, &plan, timezoneOr(plan.Timezone, utils.DefaultTimezone)); err != nil {
+					return "", err
+				}
+				_, err = r.MealPlans.Store(ctx, &plan, actor)
+			}
+			return models.ImportCreate, err
+		}
+		if err != nil {
+			return "", err
+		}
+
+		// Patch writes every column, zero values included, and keeps what
+		// the file has no column for
+		if apply {
+			if err := mpu.Schedule(ctx, r, &plan, timezoneOr(plan.Timezone, existing.Timezone)); err != nil {
+				return "", err
+			}
+
+			patch := models.NewMealPlanPatch(existing)
+			patch.MealPlanName = plan.MealPlanName
+			patch.MaxCapacity = plan.MaxCapacity
+			patch.Price = plan.Price
+			patch.Day = plan.Day
+			patch.StartTime = plan.StartClock
+			patch.EndTime = plan.EndClock
+			patch.Timezone = plan.Timezone
+
+			_, err = r.MealPlans.Patch(ctx, existing.ID, 0, patch, actor)
+		}
+		return models.ImportUpdate, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// timezoneOr returns timezone, or fallback when the row left it empty
+func timezoneOr(timezone string, fallback string) string {
+	if timezone == "" {
+		return fallback
+	}
+
+	return timezone
+}
+
+func newReport(total int, dryRun bool) *models.ImportReport {
+	report := &models.ImportReport{
+		DryRun: dryRun,
+		Total:  total,
+		Rows:   make([]models.ImportRow, total),
+	}
+
+	for i := range report.Rows {
+		report.Rows[i].Row = i + 1
+	}
+
+	return report
+}
+
+// upsert runs row for every valid row of the report inside one unit of work
+// and records the action it returned. Rows repeating an earlier name are
+// reported as updates, which is what applying them does.
+func (u *bulkUsecase) upsert(ctx context.Context, report *models.ImportReport, row func(r uow.Repositories, i int, apply bool) (string, error)) error {
+	for _, r := range report.Rows {
+		if len(r.Errors) > 0 {
+			report.Invalid++
+		}
+	}
+
+	apply := !report.DryRun && report.Invalid == 0
+	seen := make(map[string]bool)
+
+	err := u.unitOfWork.Do(ctx, func(r uow.Repositories) error {
+		for i := range report.Rows {
+			current := &report.Rows[i]
+
+			if len(current.Errors) > 0 {
+				current.Action = models.ImportInvalid
+				continue
+			}
+
+			action, err := row(r, i, apply)
+			if err != nil {
+				return err
+			}
+
+			if seen[current.Name] {
+				action = models.ImportUpdate
+			}
+			seen[current.Name] = true
+
+			current.Action = action
+			if action == models.ImportCreate {
+				report.Created++
+			} else {
+				report.Updated++
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	report.Applied = apply
+
+	return nil
+}
+
+func parseBranch(record codec.Record) (models.Branch, []utils.FieldError) {
+	var errs []utils.FieldError
+
+	b := models.Branch{
+		BranchName:   record["branch_name"],
+		OpeningHours: uint8(parseUint(record, "opening_hours", 8, &errs)),
+		BranchLocations: models.BranchLocation{
+			Latitude:  parseFloat(record, "latitude", &errs),
+			Longitude: parseFloat(record, "longitude", &errs),
+		},
+	}
+
+	return b, validate(&b, errs)
+}
+
+func parseMealPlan(record codec.Record) (models.MealPlan, []utils.FieldError) {
+	var errs []utils.FieldError
+
+	p := models.MealPlan{
+		MealPlanName: record["meal_plan_name"],
+		MaxCapacity:  uint8(parseUint(record, "max_capacity", 8, &errs)),
+		Price:        parseUint(record, "price", 64, &errs),
+		Day:          record["day"],
+		StartClock:   parseClock(record, "start_time", &errs),
+		EndClock:     parseClock(record, "end_time", &errs),
+		Timezone:     record["timezone"],
+	}
+
+	if p.Timezone != "" {
+		if _, err := utils.LoadTimezone(p.Timezone); err != nil {
+			errs = append(errs, utils.InvalidRule("timezone", "timezone", "").Fields...)
+		}
+	}
+
+	// Sessions that parsed are checked the way Schedule will, so a row is
+	// reported rather than failing the whole import
+	malformed := false
+	for _, fe := range errs {
+		malformed = malformed || fe.Field == "start_time" || fe.Field == "end_time"
+	}
+
+	if _, ok := utils.ParseWeekday(p.Day); ok && !malformed {
+		var invalid *utils.Error
+		if _, err := utils.CheckSession(p.Day, p.StartClock, p.EndClock); errors.As(err, &invalid) {
+			errs = append(errs, invalid.Fields...)
+		}
+	}
+
+	return p, validate(&p, errs)
+}
+
+// validate appends the validator's errors for fields that parsed, so a field
+// is not reported both as malformed and as missing.
+func validate(s interface{}, errs []utils.FieldError) []utils.FieldError {
+	err := utils.NewValidator().Struct(s)
+	if err == nil {
+		return errs
+	}
+
+	malformed := make(map[string]bool)
+	for _, fe := range errs {
+		malformed[fe.Field] = true
+	}
+
+	for _, fe := range utils.Validation(err).Fields {
+		if !malformed[fe.Field] {
+			errs = append(errs, fe)
+		}
+	}
+
+	return errs
+}
+
+func parseUint(record codec.Record, field string, bits int, errs *[]utils.FieldError) uint64 {
+	if record[field] == "" {
+		return 0
+	}
+
+	v, err := strconv.ParseUint(record[field], 10, bits)
+	if err != nil {
+		*errs = append(*errs, utils.FieldError{Field: field, Rule: "numeric", Message: field + " must be a whole number"})
+	}
+
+	return v
+}
+
+func parseFloat(record codec.Record, field string, errs *[]utils.FieldError) float64 {
+	if record[field] == "" {
+		return 0
+	}
+
+	v, err := strconv.ParseFloat(record[field], 64)
+	if err != nil {
+		*errs = append(*errs, utils.FieldError{Field: field, Rule: "numeric", Message: field + " must be a number"})
+	}
+
+	return v
+}
+
+func parseClock(record codec.Record, field string, errs *[]utils.FieldError) string {
+	if record[field] == "" {
+		*errs = append(*errs, utils.InvalidRule(field, "required", "").Fields...)
+		return ""
+	}
+
+	if _, ok := utils.ParseClock(record[field]); !ok {
+		*errs = append(*errs, utils.InvalidRule(field, "clock", "").Fields...)
+	}
+
+	return record[field]
+}
+
+// ExportBranches writes every live branch to w, one page at a time.
+func (u *bulkUsecase) ExportBranches(ctx context.Context, format codec.Format, w io.Writer) error {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	out, err := codec.NewWriter(format, w, branchColumns)
+	if err != nil {
+		return err
+	}
+
+	for page := int64(1); ; page++ {
+		branches, err := u.branchRepo.Fetch(ctx, exportPageSize, page, "created_at, id", false)
+		if err != nil {
+			return err
+		}
+
+		for _, b := range *branches {
+			if err := out.Write([]string{
+				b.BranchName,
+				strconv.FormatUint(uint64(b.OpeningHours), 10),
+				strconv.FormatFloat(b.BranchLocations.Latitude, 'f', -1, 64),
+				strconv.FormatFloat(b.BranchLocations.Longitude, 'f', -1, 64),
+			}); err != nil {
+				return err
+			}
+		}
+		flush(w)
+
+		if len(*branches) < exportPageSize {
+			break
+		}
+	}
+
+	return out.Close()
+}
+
+// ExportMealPlans writes every live meal plan to w, one page at a time.
+func (u *bulkUsecase) ExportMealPlans(ctx context.Context, format codec.Format, w io.Writer) error {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	out, err := codec.NewWriter(format, w, mealPlanColumns)
+	if err != nil {
+		return err
+	}
+
+	for page := int64(1); ; page++ {
+		plans, err := u.mealPlanRepo.Fetch(ctx, exportPageSize, page, "created_at, id", false)
+		if err != nil {
+			return err
+		}
+
+		for _, p := range *plans {
+			if err := out.Write([]string{
+				p.MealPlanName,
+				strconv.FormatUint(uint64(p.MaxCapacity), 10),
+				strconv.FormatUint(p.Price, 10),
+				p.Day,
+				clockHHMM(p.StartClock),
+				clockHHMM(p.EndClock),
+				p.Timezone,
+			}); err != nil {
+				return err
+			}
+		}
+		flush(w)
+
+		if len(*plans) < exportPageSize {
+			break
+		}
+	}
+
+	return out.Close()
+}
+
+// clockHHMM drops the seconds of a stored time of day when they are zero, so
+// exported times read like the HH:MM ones imported
+func clockHHMM(clock string) string {
+	if d, ok := utils.ParseClock(clock); ok && d%time.Minute == 0 {
+		return time.Time{}.Add(d).Format("15:04")
+	}
+
+	return clock
+}
+
+// flush pushes what was written so far to HTTP clients
+func flush(w io.Writer) {
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
@@ -0,0 +1,62 @@
+package events
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Handler reacts to a published event. Events are delivered at least once,
+// so handlers must be idempotent.
+type Handler func(env Envelope) error
+
+// Dispatcher fans published events out to in-process subscribers
+type Dispatcher struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+	all      []Handler
+}
+
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{
+		handlers: make(map[string][]Handler),
+	}
+}
+
+// Subscribe registers h for the named events
+func (d *Dispatcher) Subscribe(h Handler, names ...string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, name := range names {
+		d.handlers[name] = append(d.handlers[name], h)
+	}
+}
+
+// SubscribeAll registers h for every event
+func (d *Dispatcher) SubscribeAll(h Handler) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.all = append(d.all, h)
+}
+
+// Publish calls every subscriber of the event and reports the ones that failed
+func (d *Dispatcher) Publish(env Envelope) error {
+	d.mu.RLock()
+	handlers := append(append([]Handler{}, d.all...), d.handlers[env.Event.Name()]...)
+	d.mu.RUnlock()
+
+	var failed []string
+	for _, h := range handlers {
+		if err := h(env); err != nil {
+			failed = append(failed, err.Error())
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("%s: %s", env.Event.Name(), strings.Join(failed, "; "))
+	}
+
+	return nil
+}
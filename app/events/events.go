@@ -0,0 +1,162 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iamaul/fatbellies/app/models"
+)
+
+// Event represent a domain event raised by a committed write
+type Event interface {
+	Name() string
+}
+
+// Envelope carries an event together with its outbox metadata
+type Envelope struct {
+	ID         uuid.UUID
+	Actor      string
+	OccurredAt time.Time
+	Event      Event
+}
+
+type BranchCreated struct {
+	Branch models.Branch `json:"branch"`
+}
+
+type BranchUpdated struct {
+	Before models.Branch `json:"before"`
+	After  models.Branch `json:"after"`
+}
+
+type BranchDeleted struct {
+	Branch models.Branch `json:"branch"`
+}
+
+type BranchRestored struct {
+	Branch    models.Branch `json:"branch"`
+	DeletedAt *time.Time    `json:"deleted_at"`
+}
+
+type MealPlanCreated struct {
+	MealPlan models.MealPlan `json:"meal_plan"`
+}
+
+type MealPlanUpdated struct {
+	Before models.MealPlan `json:"before"`
+	After  models.MealPlan `json:"after"`
+}
+
+type MealPlanDeleted struct {
+	MealPlan models.MealPlan `json:"meal_plan"`
+}
+
+type MealPlanRestored struct {
+	MealPlan  models.MealPlan `json:"meal_plan"`
+	DeletedAt *time.Time      `json:"deleted_at"`
+}
+
+type MealPlanLinked struct {
+	BranchID   uuid.UUID `json:"branch_id"`
+	MealPlanID uuid.UUID `json:"meal_plan_id"`
+}
+
+type MealPlanUnlinked struct {
+	BranchID   uuid.UUID `json:"branch_id"`
+	MealPlanID uuid.UUID `json:"meal_plan_id"`
+}
+
+func (BranchCreated) Name() string    { return models.EventBranchCreated }
+func (BranchUpdated) Name() string    { return models.EventBranchUpdated }
+func (BranchDeleted) Name() string    { return models.EventBranchDeleted }
+func (BranchRestored) Name() string   { return models.EventBranchRestored }
+func (MealPlanCreated) Name() string  { return models.EventMealPlanCreated }
+func (MealPlanUpdated) Name() string  { return models.EventMealPlanUpdated }
+func (MealPlanDeleted) Name() string  { return models.EventMealPlanDeleted }
+func (MealPlanRestored) Name() string { return models.EventMealPlanRestored }
+func (MealPlanLinked) Name() string   { return models.EventMealPlanLinked }
+func (MealPlanUnlinked) Name() string { return models.EventMealPlanUnlinked }
+
+// registry maps an event name back to its type when reading the outbox
+var registry = map[string]func() Event{
+	models.EventBranchCreated:    func() Event { return &BranchCreated{} },
+	models.EventBranchUpdated:    func() Event { return &BranchUpdated{} },
+	models.EventBranchDeleted:    func() Event { return &BranchDeleted{} },
+	models.EventBranchRestored:   func() Event { return &BranchRestored{} },
+	models.EventMealPlanCreated:  func() Event { return &MealPlanCreated{} },
+	models.EventMealPlanUpdated:  func() Event { return &MealPlanUpdated{} },
+	models.EventMealPlanDeleted:  func() Event { return &MealPlanDeleted{} },
+	models.EventMealPlanRestored: func() Event { return &MealPlanRestored{} },
+	models.EventMealPlanLinked:   func() Event { return &MealPlanLinked{} },
+	models.EventMealPlanUnlinked: func() Event { return &MealPlanUnlinked{} },
+}
+
+// NewOutboxEvent serializes an event raised by actor into an outbox row.
+// Repositories create it in the same transaction as the write that raised it.
+func NewOutboxEvent(actor string, e Event) (*models.OutboxEvent, error) {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	return &models.OutboxEvent{
+		Name:          e.Name(),
+		Payload:       string(payload),
+		Actor:         actor,
+		OccurredAt:    now,
+		NextAttemptAt: now,
+	}, nil
+}
+
+// Decode turns an outbox row back into a typed event
+func Decode(row models.OutboxEvent) (Envelope, error) {
+	factory, ok := registry[row.Name]
+	if !ok {
+		return Envelope{}, fmt.Errorf("unknown event %q", row.Name)
+	}
+
+	e := factory()
+	if err := json.Unmarshal([]byte(row.Payload), e); err != nil {
+		return Envelope{}, err
+	}
+
+	return Envelope{
+		ID:         row.ID,
+		Actor:      row.Actor,
+		OccurredAt: row.OccurredAt,
+		Event:      deref(e),
+	}, nil
+}
+
+// deref hands subscribers the value type so they can switch on BranchCreated
+// rather than *BranchCreated.
+func deref(e Event) Event {
+	switch v := e.(type) {
+	case *BranchCreated:
+		return *v
+	case *BranchUpdated:
+		return *v
+	case *BranchDeleted:
+		return *v
+	case *BranchRestored:
+		return *v
+	case *MealPlanCreated:
+		return *v
+	case *MealPlanUpdated:
+		return *v
+	case *MealPlanDeleted:
+		return *v
+	case *MealPlanRestored:
+		return *v
+	case *MealPlanLinked:
+		return *v
+	case *MealPlanUnlinked:
+		return *v
+	}
+
+	return e
+}
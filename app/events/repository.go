@@ -0,0 +1,17 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iamaul/fatbellies/app/models"
+)
+
+// Repository represent the event outbox contract
+type Repository interface {
+	ClaimUnpublished(ctx context.Context, limit int, maxAttempts int, lease time.Duration) (*[]models.OutboxEvent, error)
+	MarkPublished(ctx context.Context, id uuid.UUID) error
+	MarkFailed(ctx context.Context, id uuid.UUID, attempts int, nextAttemptAt time.Time, reason string) error
+	DeletePublished(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iamaul/fatbellies/app/events"
+	"github.com/iamaul/fatbellies/app/models"
+	"github.com/iamaul/fatbellies/config/database"
+	"github.com/jinzhu/gorm"
+)
+
+type outboxRepository struct {
+	Db *gorm.DB
+}
+
+func NewOutboxRepository(connection *gorm.DB) events.Repository {
+	return &outboxRepository{connection}
+}
+
+func (or *outboxRepository) conn(ctx context.Context) *gorm.DB {
+	return database.WithContext(ctx, or.Db)
+}
+
+// ClaimUnpublished locks due events in commit order and pushes their next
+// attempt forward by lease, so other replicas skip them while they are relayed.
+func (or *outboxRepository) ClaimUnpublished(ctx context.Context, limit int, maxAttempts int, lease time.Duration) (res *[]models.OutboxEvent, err error) {
+	rows := &[]models.OutboxEvent{}
+	now := time.Now()
+
+	err = or.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Set("gorm:query_option", "FOR UPDATE SKIP LOCKED").
+			Where("published_at IS NULL AND attempts < ? AND next_attempt_at <= ?", maxAttempts, now).
+			Order("occurred_at").Limit(limit).Find(rows).Error; err != nil {
+			return err
+		}
+
+		ids := make([]uuid.UUID, 0, len(*rows))
+		for _, row := range *rows {
+			ids = append(ids, row.ID)
+		}
+
+		if len(ids) == 0 {
+			return nil
+		}
+
+		return tx.Model(&models.OutboxEvent{}).Where("id IN (?)", ids).
+			UpdateColumn("next_attempt_at", now.Add(lease)).Error
+	})
+	if err != nil {
+		return
+	}
+
+	res = rows
+
+	return
+}
+
+func (or *outboxRepository) MarkPublished(ctx context.Context, id uuid.UUID) (err error) {
+	err = or.conn(ctx).Model(&models.OutboxEvent{}).Where("id = ?", id).UpdateColumns(map[string]interface{}{
+		"published_at": time.Now(),
+		"last_error":   "",
+	}).Error
+
+	return
+}
+
+func (or *outboxRepository) MarkFailed(ctx context.Context, id uuid.UUID, attempts int, nextAttemptAt time.Time, reason string) (err error) {
+	err = or.conn(ctx).Model(&models.OutboxEvent{}).Where("id = ?", id).UpdateColumns(map[string]interface{}{
+		"attempts":        attempts,
+		"next_attempt_at": nextAttemptAt,
+		"last_error":      reason,
+	}).Error
+
+	return
+}
+
+// DeletePublished deletes the events published before the given time.
+// Events never published are kept whatever their age.
+func (or *outboxRepository) DeletePublished(ctx context.Context, before time.Time) (deleted int64, err error) {
+	db := or.conn(ctx).Where("published_at < ?", before).Delete(&models.OutboxEvent{})
+	deleted, err = db.RowsAffected, db.Error
+
+	return
+}
+
+// Record adds the event to the outbox using tx, so it is only relayed if the
+// surrounding write commits.
+func Record(tx *gorm.DB, actor string, e events.Event) error {
+	row, err := events.NewOutboxEvent(actor, e)
+	if err != nil {
+		return err
+	}
+
+	return tx.Create(row).Error
+}
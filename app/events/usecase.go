@@ -0,0 +1,6 @@
+package events
+
+// Usecase represent the outbox relay
+type Usecase interface {
+	Relay() error
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/iamaul/fatbellies/app/events"
+	"github.com/iamaul/fatbellies/utils"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	relayBatchSize = 100
+	relayLease     = time.Minute
+	maxAttempts    = 10
+)
+
+type relayUsecase struct {
+	outboxRepo    events.Repository
+	dispatcher    *events.Dispatcher
+	retention     time.Duration
+	sweepInterval time.Duration
+	lastSweep     time.Time
+}
+
+// NewRelayUsecase returns the outbox relay. Published events are deleted
+// once they are older than retention, checked every sweepInterval; a
+// retention of 0 keeps them.
+func NewRelayUsecase(or events.Repository, d *events.Dispatcher, retention time.Duration, sweepInterval time.Duration) events.Usecase {
+	return &relayUsecase{
+		outboxRepo:    or,
+		dispatcher:    d,
+		retention:     retention,
+		sweepInterval: sweepInterval,
+	}
+}
+
+// Relay publishes committed outbox events to the dispatcher. Events whose
+// subscribers fail are retried with backoff. Old published events are swept
+// afterwards.
+func (ru *relayUsecase) Relay() error {
+	ctx := context.Background()
+
+	rows, err := ru.outboxRepo.ClaimUnpublished(ctx, relayBatchSize, maxAttempts, relayLease)
+	if err != nil {
+		return err
+	}
+
+	for _, row := range *rows {
+		env, err := events.Decode(row)
+		if err == nil {
+			err = ru.dispatcher.Publish(env)
+		}
+
+		if err == nil {
+			if err := ru.outboxRepo.MarkPublished(ctx, row.ID); err != nil {
+				logrus.Error(err)
+			}
+			continue
+		}
+
+		attempts := row.Attempts + 1
+
+		logrus.WithFields(logrus.Fields{
+			"event":   row.ID,
+			"name":    row.Name,
+			"attempt": attempts,
+		}).Warn(err)
+
+		if err := ru.outboxRepo.MarkFailed(ctx, row.ID, attempts, time.Now().Add(utils.Backoff(attempts)), err.Error()); err != nil {
+			logrus.Error(err)
+		}
+	}
+
+	return ru.sweep(ctx, time.Now())
+}
+
+// sweep deletes the events published longer than the retention ago, at most
+// once every sweep interval
+func (ru *relayUsecase) sweep(ctx context.Context, now time.Time) error {
+	if ru.retention <= 0 || now.Sub(ru.lastSweep) < ru.sweepInterval {
+		return nil
+	}
+
+	deleted, err := ru.outboxRepo.DeletePublished(ctx, now.Add(-ru.retention))
+	if err != nil {
+		return err
+	}
+
+	ru.lastSweep = now
+
+	if deleted > 0 {
+		logrus.WithField("deleted", deleted).Info("swept published outbox events")
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/iamaul/fatbellies/app/events"
+)
+
+// outboxRepo records the sweeps of the relay
+type outboxRepo struct {
+	events.Repository
+	sweeps []time.Time
+	err    error
+}
+
+func (or *outboxRepo) DeletePublished(ctx context.Context, before time.Time) (int64, error) {
+	if or.err != nil {
+		return 0, or.err
+	}
+
+	or.sweeps = append(or.sweeps, before)
+
+	return 1, nil
+}
+
+func TestSweep(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	t.Run("deletes events published before the retention", func(t *testing.T) {
+		repo := &outboxRepo{}
+		ru := &relayUsecase{outboxRepo: repo, retention: 24 * time.Hour, sweepInterval: time.Hour}
+
+		if err := ru.sweep(context.Background(), now); err != nil {
+			t.Fatal(err)
+		}
+
+		if len(repo.sweeps) != 1 || !repo.sweeps[0].Equal(now.Add(-24*time.Hour)) {
+			t.Errorf("sweeps = %v, want one before %v", repo.sweeps, now.Add(-24*time.Hour))
+		}
+	})
+
+	t.Run("at most once every sweep interval", func(t *testing.T) {
+		repo := &outboxRepo{}
+		ru := &relayUsecase{outboxRepo: repo, retention: 24 * time.Hour, sweepInterval: time.Hour}
+
+		for _, at := range []time.Time{now, now.Add(time.Second), now.Add(59 * time.Minute), now.Add(time.Hour)} {
+			if err := ru.sweep(context.Background(), at); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if len(repo.sweeps) != 2 {
+			t.Errorf("swept %d times, want 2", len(repo.sweeps))
+		}
+	})
+
+	t.Run("no retention keeps every event", func(t *testing.T) {
+		repo := &outboxRepo{}
+		ru := &relayUsecase{outboxRepo: repo, sweepInterval: time.Hour}
+
+		if err := ru.sweep(context.Background(), now); err != nil || len(repo.sweeps) != 0 {
+			t.Errorf("sweep() = %v with %d sweeps, want none", err, len(repo.sweeps))
+		}
+	})
+
+	t.Run("failed sweeps are retried on the next relay", func(t *testing.T) {
+		repo := &outboxRepo{err: errors.New("connection refused")}
+		ru := &relayUsecase{outboxRepo: repo, retention: 24 * time.Hour, sweepInterval: time.Hour}
+
+		if err := ru.sweep(context.Background(), now); err == nil {
+			t.Fatal("sweep() = nil, want the repository's error")
+		}
+
+		repo.err = nil
+		if err := ru.sweep(context.Background(), now.Add(time.Second)); err != nil || len(repo.sweeps) != 1 {
+			t.Errorf("sweep() = %v with %d sweeps, want one", err, len(repo.sweeps))
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	graphql "github.com/graph-gophers/graphql-go"
+	"github.com/iamaul/fatbellies/app/branch"
+	"github.com/iamaul/fatbellies/app/graph"
+	mealPlan "github.com/iamaul/fatbellies/app/meal_plan"
+	"github.com/iamaul/fatbellies/utils"
+	"github.com/labstack/echo/v4"
+)
+
+type GraphHandler struct {
+	Schema       *graphql.Schema
+	Branchcase   branch.Usecase
+	Mealplancase mealPlan.Usecase
+}
+
+type graphRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+func NewGraphHandler(e *echo.Echo, bu branch.Usecase, mpu mealPlan.Usecase) {
+	handler := &GraphHandler{
+		Schema:       graph.NewSchema(bu, mpu),
+		Branchcase:   bu,
+		Mealplancase: mpu,
+	}
+
+	e.GET("/graphql", handler.Query)
+	e.POST("/graphql", handler.Query)
+}
+
+// Query runs a GraphQL query sent either as a JSON body or, for GET, in the
+// query, operationName and variables parameters. Errors are reported in the
+// GraphQL response, which is always sent with 200 once the request parsed.
+func (gh *GraphHandler) Query(c echo.Context) error {
+	var req graphRequest
+
+	if c.Request().Method == http.MethodGet {
+		req.Query = c.QueryParam("query")
+		req.OperationName = c.QueryParam("operationName")
+		if vars := c.QueryParam("variables"); vars != "" {
+			if err := json.Unmarshal([]byte(vars), &req.Variables); err != nil {
+				return utils.ErrInvalidBody.Wrap(err)
+			}
+		}
+	} else if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+		return utils.ErrInvalidBody.Wrap(err)
+	}
+
+	if req.Query == "" {
+		return utils.InvalidField("query", "required", "query is required")
+	}
+
+	// Loaders cache per request so one query never sees another's data
+	ctx := graph.WithLoaders(c.Request().Context(), graph.NewLoaders(gh.Branchcase, gh.Mealplancase))
+
+	res := gh.Schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+
+	return c.JSON(http.StatusOK, res)
+}
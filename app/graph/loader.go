@@ -0,0 +1,122 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/graph-gophers/dataloader/v7"
+	"github.com/iamaul/fatbellies/app/branch"
+	mealPlan "github.com/iamaul/fatbellies/app/meal_plan"
+	"github.com/iamaul/fatbellies/app/models"
+	"github.com/iamaul/fatbellies/utils"
+)
+
+// Loaders batch the lookups resolvers make while walking one query, so
+// listing the meal plans of fifty branches costs two queries instead of
+// fifty. They cache for the lifetime of a request and must not be shared
+// between requests.
+type Loaders struct {
+	Branches          *dataloader.Loader[uuid.UUID, models.Branch]
+	MealPlans         *dataloader.Loader[uuid.UUID, models.MealPlan]
+	BranchLocations   *dataloader.Loader[uuid.UUID, *models.BranchLocation]
+	BranchMealPlanIDs *dataloader.Loader[uuid.UUID, []uuid.UUID]
+	MealPlanBranchIDs *dataloader.Loader[uuid.UUID, []uuid.UUID]
+}
+
+func NewLoaders(bu branch.Usecase, mpu mealPlan.Usecase) *Loaders {
+	return &Loaders{
+		Branches: dataloader.NewBatchedLoader(func(ctx context.Context, ids []uuid.UUID) []*dataloader.Result[models.Branch] {
+			branches, err := bu.GetByIDs(ctx, ids)
+
+			found := make(map[uuid.UUID]models.Branch, len(branches))
+			for _, b := range branches {
+				found[b.ID] = b
+			}
+
+			return results(ids, found, err, utils.ErrBranchNotFound)
+		}),
+		MealPlans: dataloader.NewBatchedLoader(func(ctx context.Context, ids []uuid.UUID) []*dataloader.Result[models.MealPlan] {
+			plans, err := mpu.GetByIDs(ctx, ids)
+
+			found := make(map[uuid.UUID]models.MealPlan, len(plans))
+			for _, p := range plans {
+				found[p.ID] = p
+			}
+
+			return results(ids, found, err, utils.ErrMealPlanNotFound)
+		}),
+		BranchLocations: dataloader.NewBatchedLoader(func(ctx context.Context, branchIDs []uuid.UUID) []*dataloader.Result[*models.BranchLocation] {
+			locations, err := bu.LocationsByBranch(ctx, branchIDs)
+
+			found := make(map[uuid.UUID]*models.BranchLocation, len(branchIDs))
+			for _, id := range branchIDs {
+				found[id] = nil
+			}
+			for i := range locations {
+				found[locations[i].BranchID] = &locations[i]
+			}
+
+			return results(branchIDs, found, err, nil)
+		}),
+		BranchMealPlanIDs: dataloader.NewBatchedLoader(func(ctx context.Context, branchIDs []uuid.UUID) []*dataloader.Result[[]uuid.UUID] {
+			links, err := bu.MealPlanLinks(ctx, branchIDs)
+
+			found := make(map[uuid.UUID][]uuid.UUID, len(branchIDs))
+			for _, id := range branchIDs {
+				found[id] = nil
+			}
+			for _, l := range links {
+				found[l.BranchID] = append(found[l.BranchID], l.MealPlanID)
+			}
+
+			return results(branchIDs, found, err, nil)
+		}),
+		MealPlanBranchIDs: dataloader.NewBatchedLoader(func(ctx context.Context, mealPlanIDs []uuid.UUID) []*dataloader.Result[[]uuid.UUID] {
+			links, err := mpu.BranchLinks(ctx, mealPlanIDs)
+
+			found := make(map[uuid.UUID][]uuid.UUID, len(mealPlanIDs))
+			for _, id := range mealPlanIDs {
+				found[id] = nil
+			}
+			for _, l := range links {
+				found[l.MealPlanID] = append(found[l.MealPlanID], l.BranchID)
+			}
+
+			return results(mealPlanIDs, found, err, nil)
+		}),
+	}
+}
+
+// results lines the values found up with the keys they were loaded for. A
+// failed batch fails every key, and keys missing from found get missing.
+func results[V any](keys []uuid.UUID, found map[uuid.UUID]V, err error, missing error) []*dataloader.Result[V] {
+	res := make([]*dataloader.Result[V], len(keys))
+
+	for i, key := range keys {
+		if err != nil {
+			res[i] = &dataloader.Result[V]{Error: err}
+			continue
+		}
+
+		v, ok := found[key]
+		if !ok {
+			res[i] = &dataloader.Result[V]{Error: missing}
+			continue
+		}
+
+		res[i] = &dataloader.Result[V]{Data: v}
+	}
+
+	return res
+}
+
+type loadersKey struct{}
+
+// WithLoaders returns a copy of ctx carrying loaders
+func WithLoaders(ctx context.Context, loaders *Loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, loaders)
+}
+
+func loadersFrom(ctx context.Context) *Loaders {
+	return ctx.Value(loadersKey{}).(*Loaders)
+}
@@ -0,0 +1,380 @@
+package graph
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	graphql "github.com/graph-gophers/graphql-go"
+	"github.com/iamaul/fatbellies/app/branch"
+	mealPlan "github.com/iamaul/fatbellies/app/meal_plan"
+	"github.com/iamaul/fatbellies/app/models"
+	"github.com/iamaul/fatbellies/utils"
+	"github.com/sirupsen/logrus"
+)
+
+const maxPageSize = 100
+
+var branchOrders = map[string]string{
+	"CREATED_AT_DESC": "created_at desc, id",
+	"CREATED_AT_ASC":  "created_at, id",
+	"NAME_ASC":        "branch_name, id",
+	"NAME_DESC":       "branch_name desc, id",
+}
+
+var mealPlanOrders = map[string]string{
+	"CREATED_AT_DESC": "created_at desc, id",
+	"CREATED_AT_ASC":  "created_at, id",
+	"NAME_ASC":        "meal_plan_name, id",
+	"NAME_DESC":       "meal_plan_name desc, id",
+	"PRICE_ASC":       "price, id",
+	"PRICE_DESC":      "price desc, id",
+}
+
+// Resolver is the root of the schema. Lists come straight from the usecases
+// without relations, which are resolved through the request's Loaders only
+// when a query asks for them.
+type Resolver struct {
+	Branchcase   branch.Usecase
+	Mealplancase mealPlan.Usecase
+}
+
+type pageArgs struct {
+	First  int32
+	Page   int32
+	Search *string
+}
+
+// limits clamps the page arguments and returns them as Fetch takes them
+func (a pageArgs) limits() (int64, int64, string) {
+	first, page, search := int64(a.First), int64(a.Page), ""
+
+	if first < 1 || first > maxPageSize {
+		first = maxPageSize
+	}
+
+	if page < 1 {
+		page = 1
+	}
+
+	if a.Search != nil {
+		search = *a.Search
+	}
+
+	return first, page, search
+}
+
+func (r *Resolver) Branches(ctx context.Context, args struct {
+	pageArgs
+	OrderBy string
+}) ([]*BranchResolver, error) {
+	limit, page, search := args.limits()
+
+	branches, err := r.Branchcase.List(ctx, limit, page, branchOrders[args.OrderBy], search)
+	if err != nil {
+		return nil, graphError(err)
+	}
+
+	res := make([]*BranchResolver, len(branches))
+	for i := range branches {
+		res[i] = &BranchResolver{branches[i]}
+	}
+
+	return res, nil
+}
+
+func (r *Resolver) Branch(ctx context.Context, args struct{ ID graphql.ID }) (*BranchResolver, error) {
+	id, err := uuid.Parse(string(args.ID))
+	if err != nil {
+		return nil, utils.ErrInvalidID
+	}
+
+	return loadBranch(ctx, id, true)
+}
+
+func (r *Resolver) MealPlans(ctx context.Context, args struct {
+	pageArgs
+	OrderBy string
+}) ([]*MealPlanResolver, error) {
+	limit, page, search := args.limits()
+
+	plans, err := r.Mealplancase.List(ctx, limit, page, mealPlanOrders[args.OrderBy], search)
+	if err != nil {
+		return nil, graphError(err)
+	}
+
+	res := make([]*MealPlanResolver, len(plans))
+	for i := range plans {
+		res[i] = &MealPlanResolver{plans[i]}
+	}
+
+	return res, nil
+}
+
+func (r *Resolver) MealPlan(ctx context.Context, args struct{ ID graphql.ID }) (*MealPlanResolver, error) {
+	id, err := uuid.Parse(string(args.ID))
+	if err != nil {
+		return nil, utils.ErrInvalidID
+	}
+
+	plan, err := loadersFrom(ctx).MealPlans.Load(ctx, id)()
+	if errors.Is(err, utils.ErrMealPlanNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, graphError(err)
+	}
+
+	return &MealPlanResolver{plan}, nil
+}
+
+func (r *Resolver) NearestBranches(ctx context.Context, args struct {
+	Latitude  float64
+	Longitude float64
+	First     int32
+}) ([]*NearbyBranchResolver, error) {
+	limit, _, _ := pageArgs{First: args.First}.limits()
+
+	locations, err := r.Branchcase.FindNearestLocation(ctx, args.Latitude, args.Longitude)
+	if err != nil {
+		return nil, graphError(err)
+	}
+
+	nearby := *locations
+	if int64(len(nearby)) > limit {
+		nearby = nearby[:limit]
+	}
+
+	res := make([]*NearbyBranchResolver, len(nearby))
+	for i := range nearby {
+		res[i] = &NearbyBranchResolver{nearby[i]}
+	}
+
+	return res, nil
+}
+
+type BranchResolver struct {
+	b models.Branch
+}
+
+func (r *BranchResolver) ID() graphql.ID {
+	return graphql.ID(r.b.ID.String())
+}
+
+func (r *BranchResolver) Name() string {
+	return r.b.BranchName
+}
+
+func (r *BranchResolver) OpeningHours() int32 {
+	return int32(r.b.OpeningHours)
+}
+
+func (r *BranchResolver) Timezone() string {
+	return r.b.Timezone
+}
+
+func (r *BranchResolver) Version() int32 {
+	return int32(r.b.Version)
+}
+
+func (r *BranchResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.b.CreatedAt}
+}
+
+func (r *BranchResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: r.b.UpdatedAt}
+}
+
+func (r *BranchResolver) Location(ctx context.Context) (*LocationResolver, error) {
+	location, err := loadersFrom(ctx).BranchLocations.Load(ctx, r.b.ID)()
+	if err != nil {
+		return nil, graphError(err)
+	}
+
+	if location == nil {
+		return nil, nil
+	}
+
+	return &LocationResolver{*location}, nil
+}
+
+func (r *BranchResolver) MealPlans(ctx context.Context, args struct{ First *int32 }) ([]*MealPlanResolver, error) {
+	loaders := loadersFrom(ctx)
+
+	ids, err := loaders.BranchMealPlanIDs.Load(ctx, r.b.ID)()
+	if err != nil {
+		return nil, graphError(err)
+	}
+
+	plans, errs := loaders.MealPlans.LoadMany(ctx, first(ids, args.First))()
+
+	res := make([]*MealPlanResolver, 0, len(plans))
+	for i := range plans {
+		// A link can outlive its meal plan until the purge removes it
+		if errs != nil && errs[i] != nil {
+			if errors.Is(errs[i], utils.ErrMealPlanNotFound) {
+				continue
+			}
+			return nil, graphError(errs[i])
+		}
+		res = append(res, &MealPlanResolver{plans[i]})
+	}
+
+	return res, nil
+}
+
+type LocationResolver struct {
+	l models.BranchLocation
+}
+
+func (r *LocationResolver) ID() graphql.ID {
+	return graphql.ID(r.l.ID.String())
+}
+
+func (r *LocationResolver) Latitude() float64 {
+	return r.l.Latitude
+}
+
+func (r *LocationResolver) Longitude() float64 {
+	return r.l.Longitude
+}
+
+func (r *LocationResolver) Branch(ctx context.Context) (*BranchResolver, error) {
+	return loadBranch(ctx, r.l.BranchID, false)
+}
+
+type NearbyBranchResolver struct {
+	l models.BranchLocation
+}
+
+func (r *NearbyBranchResolver) Distance() float64 {
+	return r.l.Distance
+}
+
+func (r *NearbyBranchResolver) Location() *LocationResolver {
+	return &LocationResolver{r.l}
+}
+
+func (r *NearbyBranchResolver) Branch(ctx context.Context) (*BranchResolver, error) {
+	return loadBranch(ctx, r.l.BranchID, false)
+}
+
+type MealPlanResolver struct {
+	p models.MealPlan
+}
+
+func (r *MealPlanResolver) ID() graphql.ID {
+	return graphql.ID(r.p.ID.String())
+}
+
+func (r *MealPlanResolver) Name() string {
+	return r.p.MealPlanName
+}
+
+func (r *MealPlanResolver) MaxCapacity() int32 {
+	return int32(r.p.MaxCapacity)
+}
+
+func (r *MealPlanResolver) Price() int32 {
+	return int32(r.p.Price)
+}
+
+func (r *MealPlanResolver) Day() string {
+	return r.p.Day
+}
+
+func (r *MealPlanResolver) StartTime() graphql.Time {
+	return graphql.Time{Time: r.p.StartTime}
+}
+
+func (r *MealPlanResolver) EndTime() graphql.Time {
+	return graphql.Time{Time: r.p.EndTime}
+}
+
+func (r *MealPlanResolver) StartClock() string {
+	return r.p.StartClock
+}
+
+func (r *MealPlanResolver) EndClock() string {
+	return r.p.EndClock
+}
+
+func (r *MealPlanResolver) Timezone() string {
+	return r.p.Timezone
+}
+
+func (r *MealPlanResolver) Version() int32 {
+	return int32(r.p.Version)
+}
+
+func (r *MealPlanResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.p.CreatedAt}
+}
+
+func (r *MealPlanResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: r.p.UpdatedAt}
+}
+
+func (r *MealPlanResolver) Branches(ctx context.Context, args struct{ First *int32 }) ([]*BranchResolver, error) {
+	loaders := loadersFrom(ctx)
+
+	ids, err := loaders.MealPlanBranchIDs.Load(ctx, r.p.ID)()
+	if err != nil {
+		return nil, graphError(err)
+	}
+
+	branches, errs := loaders.Branches.LoadMany(ctx, first(ids, args.First))()
+
+	res := make([]*BranchResolver, 0, len(branches))
+	for i := range branches {
+		if errs != nil && errs[i] != nil {
+			if errors.Is(errs[i], utils.ErrBranchNotFound) {
+				continue
+			}
+			return nil, graphError(errs[i])
+		}
+		res = append(res, &BranchResolver{branches[i]})
+	}
+
+	return res, nil
+}
+
+// loadBranch resolves a branch through the loader. A missing branch is null
+// when nullable is set and an error otherwise.
+func loadBranch(ctx context.Context, id uuid.UUID, nullable bool) (*BranchResolver, error) {
+	b, err := loadersFrom(ctx).Branches.Load(ctx, id)()
+	if nullable && errors.Is(err, utils.ErrBranchNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, graphError(err)
+	}
+
+	return &BranchResolver{b}, nil
+}
+
+// first returns at most n ids, or all of them when n is not set
+func first(ids []uuid.UUID, n *int32) []uuid.UUID {
+	if n != nil && *n >= 0 && int(*n) < len(ids) {
+		return ids[:*n]
+	}
+
+	return ids
+}
+
+// graphError keeps domain errors, which are safe to show and carry their
+// code, and logs anything else before hiding it behind ErrInternal.
+func graphError(err error) error {
+	if utils.IsTimeout(err) {
+		return utils.ErrTimeout
+	}
+
+	var domainErr *utils.Error
+	if errors.As(err, &domainErr) && domainErr.Kind != utils.KindInternal {
+		return domainErr
+	}
+
+	logrus.WithField("component", "graphql").Error(err)
+
+	return utils.ErrInternal
+}
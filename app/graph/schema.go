@@ -0,0 +1,27 @@
+package graph
+
+import (
+	_ "embed"
+
+	graphql "github.com/graph-gophers/graphql-go"
+	"github.com/iamaul/fatbellies/app/branch"
+	mealPlan "github.com/iamaul/fatbellies/app/meal_plan"
+)
+
+//go:embed schema.graphql
+var schema string
+
+// maxDepth bounds how far a query can follow relations, so a client cannot
+// walk branch -> mealPlans -> branches indefinitely.
+const maxDepth = 10
+
+// NewSchema parses the schema and binds it to the usecases. It panics when
+// the resolvers do not match the schema, which is a programming error.
+func NewSchema(bu branch.Usecase, mpu mealPlan.Usecase) *graphql.Schema {
+	resolver := &Resolver{
+		Branchcase:   bu,
+		Mealplancase: mpu,
+	}
+
+	return graphql.MustParseSchema(schema, resolver, graphql.MaxDepth(maxDepth))
+}
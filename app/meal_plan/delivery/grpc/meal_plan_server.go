@@ -0,0 +1,218 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	mealPlan "github.com/iamaul/fatbellies/app/meal_plan"
+	"github.com/iamaul/fatbellies/app/models"
+	pb "github.com/iamaul/fatbellies/proto/fatbellies/v1"
+	"github.com/iamaul/fatbellies/utils"
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultPageSize = 10
+	maxPageSize     = 100
+)
+
+var mealPlanOrders = map[pb.MealPlanOrder]string{
+	pb.MealPlanOrder_MEAL_PLAN_ORDER_CREATED_AT_DESC: "created_at desc, id",
+	pb.MealPlanOrder_MEAL_PLAN_ORDER_CREATED_AT_ASC:  "created_at, id",
+	pb.MealPlanOrder_MEAL_PLAN_ORDER_NAME_ASC:        "meal_plan_name, id",
+	pb.MealPlanOrder_MEAL_PLAN_ORDER_NAME_DESC:       "meal_plan_name desc, id",
+	pb.MealPlanOrder_MEAL_PLAN_ORDER_PRICE_ASC:       "price, id",
+	pb.MealPlanOrder_MEAL_PLAN_ORDER_PRICE_DESC:      "price desc, id",
+}
+
+type MealPlanServer struct {
+	pb.UnimplementedMealPlanServiceServer
+	Mealplancase mealPlan.Usecase
+}
+
+func NewMealPlanServer(s *ggrpc.Server, mpu mealPlan.Usecase) {
+	server := &MealPlanServer{
+		Mealplancase: mpu,
+	}
+
+	pb.RegisterMealPlanServiceServer(s, server)
+}
+
+func (ms *MealPlanServer) ListMealPlans(ctx context.Context, req *pb.ListMealPlansRequest) (*pb.ListMealPlansResponse, error) {
+	limit, page := pageLimits(req.PageSize, req.Page)
+
+	res, err := ms.Mealplancase.List(ctx, limit, page, mealPlanOrders[req.OrderBy], req.Search)
+	if err != nil {
+		return nil, utils.GRPCError(err)
+	}
+
+	plans := make([]*pb.MealPlan, len(res))
+	for i := range res {
+		plans[i] = mealPlanToProto(res[i])
+	}
+
+	return &pb.ListMealPlansResponse{MealPlans: plans}, nil
+}
+
+func (ms *MealPlanServer) StreamMealPlans(req *pb.StreamMealPlansRequest, stream pb.MealPlanService_StreamMealPlansServer) error {
+	ctx := stream.Context()
+
+	for page := int64(1); ; page++ {
+		res, err := ms.Mealplancase.List(ctx, maxPageSize, page, mealPlanOrders[req.OrderBy], req.Search)
+		if err != nil {
+			return utils.GRPCError(err)
+		}
+
+		for i := range res {
+			if err := stream.Send(mealPlanToProto(res[i])); err != nil {
+				return err
+			}
+		}
+
+		if len(res) < maxPageSize {
+			return nil
+		}
+	}
+}
+
+func (ms *MealPlanServer) GetMealPlan(ctx context.Context, req *pb.GetMealPlanRequest) (*pb.MealPlan, error) {
+	id, err := parseID(req.Id)
+	if err != nil {
+		return nil, utils.GRPCError(err)
+	}
+
+	res, err := ms.Mealplancase.GetByID(ctx, id)
+	if err != nil {
+		return nil, utils.GRPCError(err)
+	}
+
+	return mealPlanToProto(res), nil
+}
+
+func (ms *MealPlanServer) CreateMealPlan(ctx context.Context, req *pb.CreateMealPlanRequest) (*pb.MealPlan, error) {
+	p := models.MealPlan{
+		MealPlanName: req.Name,
+		MaxCapacity:  uint8(req.MaxCapacity),
+		Price:        req.Price,
+		Day:          req.Day,
+		StartTime:    timeFromProto(req.StartTime),
+		EndTime:      timeFromProto(req.EndTime),
+	}
+
+	if err := utils.NewValidator().Struct(&p); err != nil {
+		return nil, utils.GRPCError(utils.Validation(err))
+	}
+
+	res, err := ms.Mealplancase.Store(ctx, &p, utils.GRPCActor(ctx))
+	if err != nil {
+		return nil, utils.GRPCError(err)
+	}
+
+	return mealPlanToProto(*res), nil
+}
+
+func (ms *MealPlanServer) UpdateMealPlan(ctx context.Context, req *pb.UpdateMealPlanRequest) (*pb.MealPlan, error) {
+	id, err := parseID(req.Id)
+	if err != nil {
+		return nil, utils.GRPCError(err)
+	}
+
+	if req.Version == 0 {
+		return nil, utils.GRPCError(utils.InvalidField("version", "required", "version is required"))
+	}
+
+	p := models.MealPlan{
+		MealPlanName: req.Name,
+		MaxCapacity:  uint8(req.MaxCapacity),
+		Price:        req.Price,
+		Day:          req.Day,
+		StartTime:    timeFromProto(req.StartTime),
+		EndTime:      timeFromProto(req.EndTime),
+	}
+
+	if err := utils.NewValidator().Struct(&p); err != nil {
+		return nil, utils.GRPCError(utils.Validation(err))
+	}
+
+	res, err := ms.Mealplancase.Update(ctx, id, req.Version, p, utils.GRPCActor(ctx))
+	if err != nil {
+		return nil, utils.GRPCError(err)
+	}
+
+	return mealPlanToProto(res), nil
+}
+
+func (ms *MealPlanServer) DeleteMealPlan(ctx context.Context, req *pb.DeleteMealPlanRequest) (*emptypb.Empty, error) {
+	id, err := parseID(req.Id)
+	if err != nil {
+		return nil, utils.GRPCError(err)
+	}
+
+	if req.Version == 0 {
+		return nil, utils.GRPCError(utils.InvalidField("version", "required", "version is required"))
+	}
+
+	if err := ms.Mealplancase.Delete(ctx, id, req.Version, utils.GRPCActor(ctx)); err != nil {
+		return nil, utils.GRPCError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func mealPlanToProto(p models.MealPlan) *pb.MealPlan {
+	return &pb.MealPlan{
+		Id:          p.ID.String(),
+		Name:        p.MealPlanName,
+		MaxCapacity: uint32(p.MaxCapacity),
+		Price:       p.Price,
+		Day:         p.Day,
+		StartTime:   timestamppb.New(p.StartTime),
+		EndTime:     timestamppb.New(p.EndTime),
+		Version:     p.Version,
+		CreatedAt:   timestamppb.New(p.CreatedAt),
+		UpdatedAt:   timestamppb.New(p.UpdatedAt),
+		Timezone:    p.Timezone,
+		StartClock:  p.StartClock,
+		EndClock:    p.EndClock,
+	}
+}
+
+// timeFromProto leaves unset timestamps as the zero time rather than the
+// Unix epoch
+func timeFromProto(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+
+	return ts.AsTime()
+}
+
+// pageLimits applies the defaults and bounds of the list requests and returns
+// them as the usecases take them
+func pageLimits(size int32, page int32) (int64, int64) {
+	limit := int64(size)
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	if page < 1 {
+		page = 1
+	}
+
+	return limit, int64(page)
+}
+
+func parseID(id string) (uuid.UUID, error) {
+	res, err := uuid.Parse(id)
+	if err != nil {
+		return res, utils.ErrInvalidID.Wrap(err)
+	}
+
+	return res, nil
+}
@@ -1,11 +1,10 @@
 package http
 
 import (
+	"io/ioutil"
 	"net/http"
 	"strconv"
-	"time"
 
-	"github.com/go-playground/validator"
 	"github.com/google/uuid"
 	mealPlan "github.com/iamaul/fatbellies/app/meal_plan"
 	"github.com/iamaul/fatbellies/app/models"
@@ -22,14 +21,29 @@ func NewMealPlanHandler(e *echo.Echo, mpu mealPlan.Usecase) {
 		Mealplancase: mpu,
 	}
 
+	v1 := e.Group("/api/v1")
+	v1.GET("/mealplans", handler.Fetch)
+	v1.POST("/mealplans", handler.Store)
+	v1.GET("/mealplans/:id", handler.GetByID)
+	v1.PUT("/mealplans/:id", handler.Update)
+	v1.PATCH("/mealplans/:id", handler.Patch)
+	v1.DELETE("/mealplans/:id", handler.Delete)
+	v1.POST("/mealplans/:id/restore", handler.Restore)
+	v1.GET("/mealplans/:id/translations", handler.FetchTranslations)
+	v1.PUT("/mealplans/:id/translations/:locale", handler.StoreTranslation)
+	v1.DELETE("/mealplans/:id/translations/:locale", handler.DeleteTranslation)
+
+	// Deprecated aliases of the v1 routes, served until utils.LegacySunset
 	g := e.Group("/api")
-	g.GET("/mealplans", handler.Fetch)
-	g.GET("/mealplans/:id", handler.GetByID)
-	g.GET("/mealplans/meal/:name", handler.GetByName)
-	g.POST("/mealplans", handler.Store)
-	g.DELETE("/delete/mealplans/:id", handler.Delete)
-	g.PUT("/update/mealplans/:id", handler.Update)
-	g.POST("/search/mealplans", handler.SearchPlans)
+	g.GET("/mealplans", handler.Fetch, utils.Deprecated("/api/v1/mealplans"))
+	g.GET("/mealplans/:id", handler.GetByID, utils.Deprecated("/api/v1/mealplans/:id"))
+	g.GET("/mealplans/meal/:name", handler.GetByName, utils.Deprecated("/api/v1/mealplans"))
+	g.POST("/mealplans", handler.Store, utils.Deprecated("/api/v1/mealplans"))
+	g.DELETE("/delete/mealplans/:id", handler.Delete, utils.Deprecated("/api/v1/mealplans/:id"))
+	g.POST("/mealplans/:id/restore", handler.Restore, utils.Deprecated("/api/v1/mealplans/:id/restore"))
+	g.PUT("/update/mealplans/:id", handler.Update, utils.Deprecated("/api/v1/mealplans/:id"))
+	g.PATCH("/mealplans/:id", handler.Patch, utils.Deprecated("/api/v1/mealplans/:id"))
+	g.POST("/search/mealplans", handler.SearchPlans, utils.Deprecated("/api/v1/mealplans"))
 }
 
 // @Summary List meal plan
@@ -39,24 +53,28 @@ func NewMealPlanHandler(e *echo.Echo, mpu mealPlan.Usecase) {
 // @Produce  json
 // @Param limit query integer 5 "limit numbers"
 // @Param page query integer 1 "pagination"
-// @Param order query string false "created_at desc"
+// @Param order query string false "created_at, updated_at, meal_plan_name, price, max_capacity, optionally followed by asc or desc; defaults to created_at desc"
+// @Param include_deleted query boolean false "include soft deleted meal plans"
+// @Param q query string false "only meal plans whose name contains q"
 // @Success 200 {array} models.MealPlan
-// @Router /api/mealplans [get]
+// @Router /mealplans [get]
 func (mph *MealPlanHandler) Fetch(c echo.Context) error {
+	ctx := c.Request().Context()
+
 	queryLimit := c.QueryParam("limit")
 	limit, _ := strconv.Atoi(queryLimit)
 	queryPage := c.QueryParam("page")
 	page, _ := strconv.Atoi(queryPage)
 	queryOrder := c.QueryParam("order")
+	includeDeleted, _ := strconv.ParseBool(c.QueryParam("include_deleted"))
+
+	if q := c.QueryParam("q"); q != "" {
+		return mph.search(c, "meal_plan_name", q, queryOrder)
+	}
 
-	res, err := mph.Mealplancase.Fetch(int64(limit), int64(page), queryOrder)
+	res, err := mph.Mealplancase.Fetch(ctx, int64(limit), int64(page), queryOrder, includeDeleted)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, &utils.ResponseJSON{
-			Code:    http.StatusInternalServerError,
-			Message: "An unexpected error has occurred",
-			Error:   err.Error(),
-			Success: false,
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, &utils.ResponseJSON{
@@ -73,26 +91,25 @@ func (mph *MealPlanHandler) Fetch(c echo.Context) error {
 // @Accept  json
 // @Produce  json
 // @Param id path string uuid "Meal Plan ID"
+// @Param If-None-Match header string false "ETag of a cached copy"
 // @Success 200 {array} models.MealPlan
-// @Router /api/mealplans/{id} [get]
+// @Success 304
+// @Router /mealplans/{id} [get]
 func (mph *MealPlanHandler) GetByID(c echo.Context) error {
+	ctx := c.Request().Context()
+
 	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, &utils.ResponseJSON{
-			Code:    http.StatusBadRequest,
-			Message: "An unexpected error has occurred",
-			Error:   err.Error(),
-			Success: false,
-		})
+		return utils.ErrInvalidID.Wrap(err)
 	}
 
-	res, err := mph.Mealplancase.GetByID(id)
+	res, err := mph.Mealplancase.GetByID(ctx, id)
 	if err != nil {
-		return c.JSON(http.StatusConflict, &utils.ResponseJSON{
-			Code:    http.StatusConflict,
-			Error:   err.Error(),
-			Success: false,
-		})
+		return err
+	}
+
+	if utils.NotModified(c, res.Version, res) {
+		return c.NoContent(http.StatusNotModified)
 	}
 
 	return c.JSON(http.StatusOK, &utils.ResponseJSON{
@@ -103,24 +120,20 @@ func (mph *MealPlanHandler) GetByID(c echo.Context) error {
 	})
 }
 
-// @Summary Find one of all the meal plans
-// @Description Get meal plan by Name
-// @Tags Meal Plans
-// @Accept  json
-// @Produce  json
-// @Param name path string false "Meal plan name"
-// @Success 200 {array} models.MealPlan
-// @Router /api/mealplans/meal/{name} [get]
+// GetByName finds a meal plan by its exact name. It only backs the deprecated
+// /api/mealplans/meal/:name route, /api/v1/mealplans?q= replaces it.
 func (mph *MealPlanHandler) GetByName(c echo.Context) error {
+	ctx := c.Request().Context()
+
 	planName := c.Param("name")
 
-	res, err := mph.Mealplancase.GetByName(planName)
+	res, err := mph.Mealplancase.GetByName(ctx, planName)
 	if err != nil {
-		return c.JSON(http.StatusConflict, &utils.ResponseJSON{
-			Code:    http.StatusConflict,
-			Error:   err.Error(),
-			Success: false,
-		})
+		return err
+	}
+
+	if utils.NotModified(c, res.Version, res) {
+		return c.NoContent(http.StatusNotModified)
 	}
 
 	return c.JSON(http.StatusOK, &utils.ResponseJSON{
@@ -131,8 +144,8 @@ func (mph *MealPlanHandler) GetByName(c echo.Context) error {
 	})
 }
 
-func createMealPlanValidation(cb *models.MealPlan) (bool, error) {
-	validate := validator.New()
+func createMealPlanValidation(cb *models.MealPlanForm) (bool, error) {
+	validate := utils.NewValidator()
 
 	err := validate.Struct(cb)
 	if err != nil {
@@ -142,54 +155,36 @@ func createMealPlanValidation(cb *models.MealPlan) (bool, error) {
 }
 
 // @Summary Add meal plan
-// @Description Add new meal plan
+// @Description Add new meal plan held every week on day, a weekday such as Friday. start_time and end_time are local times of day in the timezone of branch_id, or in the default timezone without a branch, and the meal plan follows that branch when its timezone changes. An end_time at or before start_time ends the following day. Responses carry them as start_clock and end_clock, and start_time and end_time are the instants of the session under way or else the next one. Times a daylight saving change skips move forward by the gap, and repeated ones are their first occurrence.
 // @Tags Meal Plans
 // @Accept  json
 // @Produce  json
-// @Param meal_plan body models.SwagMealPlan true "Form JSON"
+// @Param meal_plan body models.MealPlanForm true "Form JSON"
 // @Success 200 {array} models.MealPlan
-// @Router /api/mealplans [post]
+// @Router /mealplans [post]
 func (mph *MealPlanHandler) Store(c echo.Context) error {
-	var mealPlan models.MealPlan
+	ctx := c.Request().Context()
+
+	var form models.MealPlanForm
 
-	err := c.Bind(&mealPlan)
+	err := c.Bind(&form)
 	if err != nil {
-		return c.JSON(http.StatusUnprocessableEntity, &utils.ResponseJSON{
-			Code:    http.StatusUnprocessableEntity,
-			Message: "An unexpected error has occurred",
-			Error:   err.Error(),
-			Success: false,
-		})
+		return utils.ErrInvalidBody.Wrap(err)
 	}
 
-	if ok, err := createMealPlanValidation(&mealPlan); !ok {
-		return c.JSON(http.StatusBadRequest, &utils.ResponseJSON{
-			Code:    http.StatusBadRequest,
-			Message: "Validation invalid",
-			Error:   err.Error(),
-			Success: false,
-		})
+	if ok, err := createMealPlanValidation(&form); !ok {
+		return utils.Validation(err)
 	}
 
-	loc, _ := time.LoadLocation("Asia/Jakarta")
-
-	// set timezone,
-	now := time.Now().In(loc)
-	end := time.Now().In(loc).Add(3)
-
-	mealPlan.StartTime = now
-	mealPlan.EndTime = end
+	mealPlan := form.MealPlan()
 
-	res, err := mph.Mealplancase.Store(&mealPlan)
+	res, err := mph.Mealplancase.Store(ctx, &mealPlan, utils.Actor(c))
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, &utils.ResponseJSON{
-			Code:    http.StatusInternalServerError,
-			Message: "An unexpected error has occurred",
-			Error:   err.Error(),
-			Success: false,
-		})
+		return err
 	}
 
+	utils.SetETag(c, res.Version, res)
+
 	return c.JSON(http.StatusCreated, &utils.ResponseJSON{
 		Code:    http.StatusCreated,
 		Result:  res,
@@ -199,55 +194,89 @@ func (mph *MealPlanHandler) Store(c echo.Context) error {
 }
 
 // @Summary Update meal plan
-// @Description Update meal plan by ID
+// @Description Update meal plan by ID. start_time and end_time are local times of day in the timezone of branch_id, or in the meal plan's timezone without a branch, held as when adding a meal plan.
 // @Tags Meal Plans
 // @Accept  json
 // @Produce  json
+// @Param If-Match header string true "ETag of the version being changed"
 // @Param id path string uuid "Meal plan ID"
-// @Param meal_plan body models.SwagMealPlan true "Form JSON"
-// @Success 200 {array} models.SwagMealPlan
-// @Router /api/update/mealplans/{id} [put]
+// @Param meal_plan body models.MealPlanForm true "Form JSON"
+// @Success 200 {array} models.MealPlan
+// @Router /mealplans/{id} [put]
 func (mph *MealPlanHandler) Update(c echo.Context) error {
-	var mealPlan models.MealPlan
+	ctx := c.Request().Context()
+
+	var form models.MealPlanForm
 
 	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, &utils.ResponseJSON{
-			Code:    http.StatusBadRequest,
-			Message: "An unexpected error has occurred",
-			Error:   err.Error(),
-			Success: false,
-		})
+		return utils.ErrInvalidID.Wrap(err)
 	}
 
-	errBind := c.Bind(&mealPlan)
+	version, err := utils.IfMatch(c)
+	if err != nil {
+		return err
+	}
+
+	errBind := c.Bind(&form)
 	if errBind != nil {
-		return c.JSON(http.StatusUnprocessableEntity, &utils.ResponseJSON{
-			Code:    http.StatusUnprocessableEntity,
-			Message: "An unexpected error has occurred",
-			Error:   errBind.Error(),
-			Success: false,
-		})
+		return utils.ErrInvalidBody.Wrap(errBind)
 	}
 
-	if ok, errValidation := createMealPlanValidation(&mealPlan); !ok {
-		return c.JSON(http.StatusBadRequest, &utils.ResponseJSON{
-			Code:    http.StatusBadRequest,
-			Message: "Validation invalid",
-			Error:   errValidation.Error(),
-			Success: false,
-		})
+	if ok, errValidation := createMealPlanValidation(&form); !ok {
+		return utils.Validation(errValidation)
 	}
 
-	res, errPlan := mph.Mealplancase.Update(id, mealPlan)
+	res, errPlan := mph.Mealplancase.Update(ctx, id, version, form.MealPlan(), utils.Actor(c))
 	if errPlan != nil {
-		return c.JSON(http.StatusNotFound, &utils.ResponseJSON{
-			Code:    http.StatusNotFound,
-			Error:   errPlan.Error(),
-			Success: false,
-		})
+		return errPlan
+	}
+
+	utils.SetETag(c, res.Version, res)
+
+	return c.JSON(http.StatusOK, &utils.ResponseJSON{
+		Code:    http.StatusOK,
+		Result:  res,
+		Message: "Plan updated successfully",
+		Success: true,
+	})
+}
+
+// @Summary Patch meal plan
+// @Description Partially update a meal plan with a JSON merge patch (RFC 7396). Only the members sent are changed and validated, and null clears a field. start_time and end_time are local times of day in the meal plan's timezone.
+// @Tags Meal Plans
+// @Accept  application/merge-patch+json
+// @Produce  json
+// @Param If-Match header string true "ETag of the version being changed"
+// @Param id path string uuid "Meal plan ID"
+// @Param meal_plan body models.MealPlanPatch true "JSON merge patch"
+// @Success 200 {object} models.MealPlan
+// @Router /mealplans/{id} [patch]
+func (mph *MealPlanHandler) Patch(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return utils.ErrInvalidID.Wrap(err)
+	}
+
+	version, err := utils.IfMatch(c)
+	if err != nil {
+		return err
 	}
 
+	patch, err := ioutil.ReadAll(c.Request().Body)
+	if err != nil {
+		return utils.ErrInvalidBody.Wrap(err)
+	}
+
+	res, err := mph.Mealplancase.Patch(ctx, id, version, patch, utils.Actor(c))
+	if err != nil {
+		return err
+	}
+
+	utils.SetETag(c, res.Version, res)
+
 	return c.JSON(http.StatusOK, &utils.ResponseJSON{
 		Code:    http.StatusOK,
 		Result:  res,
@@ -261,27 +290,26 @@ func (mph *MealPlanHandler) Update(c echo.Context) error {
 // @Tags Meal Plans
 // @Accept  json
 // @Produce  json
+// @Param If-Match header string true "ETag of the version being changed"
 // @Param id path string false "Meal plan ID"
 // @Success 200
-// @Router /api/delete/mealplans/{id} [delete]
+// @Router /mealplans/{id} [delete]
 func (mph *MealPlanHandler) Delete(c echo.Context) error {
+	ctx := c.Request().Context()
+
 	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, &utils.ResponseJSON{
-			Code:    http.StatusBadRequest,
-			Message: "An unexpected error has occurred",
-			Error:   err.Error(),
-			Success: false,
-		})
+		return utils.ErrInvalidID.Wrap(err)
 	}
 
-	err = mph.Mealplancase.Delete(id)
+	version, err := utils.IfMatch(c)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, &utils.ResponseJSON{
-			Code:    http.StatusNotFound,
-			Error:   err.Error(),
-			Success: false,
-		})
+		return err
+	}
+
+	err = mph.Mealplancase.Delete(ctx, id, version, utils.Actor(c))
+	if err != nil {
+		return err
 	}
 
 	return c.JSON(http.StatusOK, &utils.ResponseJSON{
@@ -291,29 +319,49 @@ func (mph *MealPlanHandler) Delete(c echo.Context) error {
 	})
 }
 
-// @Summary Search meal plans
-// @Description Search meal plan by specific queries column, q, and ID
+// @Summary Restore meal plan
+// @Description Restore a soft deleted meal plan with its branch links
 // @Tags Meal Plans
 // @Accept  json
 // @Produce  json
-// @Param column query string false "meal_plan_name"
-// @Param q query string false "Buffet A"
-// @Param order query string false "created_at desc"
-// @Success 200 {array} models.MealPlan
-// @Router /api/search/mealplans [post]
+// @Param id path string uuid "Meal plan ID"
+// @Success 200 {object} models.MealPlan
+// @Router /mealplans/{id}/restore [post]
+func (mph *MealPlanHandler) Restore(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return utils.ErrInvalidID.Wrap(err)
+	}
+
+	res, err := mph.Mealplancase.Restore(ctx, id, utils.Actor(c))
+	if err != nil {
+		return err
+	}
+
+	utils.SetETag(c, res.Version, res)
+
+	return c.JSON(http.StatusOK, &utils.ResponseJSON{
+		Code:    http.StatusOK,
+		Result:  res,
+		Message: "Plan restored successfully",
+		Success: true,
+	})
+}
+
+// SearchPlans only backs the deprecated /api/search/mealplans route,
+// /api/v1/mealplans?q= replaces it.
 func (mph *MealPlanHandler) SearchPlans(c echo.Context) error {
-	column := c.QueryParam("column")
-	label := c.QueryParam("q")
-	order := c.QueryParam("order")
+	return mph.search(c, c.QueryParam("column"), c.QueryParam("q"), c.QueryParam("order"))
+}
 
-	res, err := mph.Mealplancase.SearchPlans(column, label, order)
+func (mph *MealPlanHandler) search(c echo.Context, column string, label string, order string) error {
+	ctx := c.Request().Context()
+
+	res, err := mph.Mealplancase.SearchPlans(ctx, column, label, order)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, &utils.ResponseJSON{
-			Code:    http.StatusInternalServerError,
-			Message: "An unexpected error has occurred",
-			Error:   err.Error(),
-			Success: false,
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, &utils.ResponseJSON{
@@ -323,3 +371,103 @@ func (mph *MealPlanHandler) SearchPlans(c echo.Context) error {
 		Success: true,
 	})
 }
+
+// @Summary List meal plan translations
+// @Description Get the translations of a meal plan, one per locale. Requests sending Accept-Language get the meal plan in the first of their languages it has a translation in.
+// @Tags Meal Plans
+// @Accept  json
+// @Produce  json
+// @Param id path string uuid "Meal plan ID"
+// @Success 200 {array} models.MealPlanTranslation
+// @Router /mealplans/{id}/translations [get]
+func (mph *MealPlanHandler) FetchTranslations(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return utils.ErrInvalidID.Wrap(err)
+	}
+
+	res, err := mph.Mealplancase.Translations(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &utils.ResponseJSON{
+		Code:    http.StatusOK,
+		Result:  res,
+		Message: "Fetched data successfully",
+		Success: true,
+	})
+}
+
+// @Summary Store meal plan translation
+// @Description Create or replace the translation of a meal plan in a locale other than the default one
+// @Tags Meal Plans
+// @Accept  json
+// @Produce  json
+// @Param id path string uuid "Meal plan ID"
+// @Param locale path string true "Language tag, such as id or zh-Hant"
+// @Param translation body models.SwagMealPlanTranslation true "Form JSON"
+// @Success 200 {object} models.MealPlanTranslation
+// @Router /mealplans/{id}/translations/{locale} [put]
+func (mph *MealPlanHandler) StoreTranslation(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return utils.ErrInvalidID.Wrap(err)
+	}
+
+	var translation models.MealPlanTranslation
+	if err := c.Bind(&translation); err != nil {
+		return utils.ErrInvalidBody.Wrap(err)
+	}
+
+	translation.MealPlanID = id
+	translation.Locale = c.Param("locale")
+
+	if err := utils.NewValidator().Struct(&translation); err != nil {
+		return utils.Validation(err)
+	}
+
+	res, err := mph.Mealplancase.StoreTranslation(ctx, &translation)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &utils.ResponseJSON{
+		Code:    http.StatusOK,
+		Result:  res,
+		Message: "Plan translation saved successfully",
+		Success: true,
+	})
+}
+
+// @Summary Delete meal plan translation
+// @Description Delete the translation of a meal plan in a locale, after which it falls back to the next language of the request
+// @Tags Meal Plans
+// @Accept  json
+// @Produce  json
+// @Param id path string uuid "Meal plan ID"
+// @Param locale path string true "Language tag"
+// @Success 200 {object} utils.ResponseJSON
+// @Router /mealplans/{id}/translations/{locale} [delete]
+func (mph *MealPlanHandler) DeleteTranslation(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return utils.ErrInvalidID.Wrap(err)
+	}
+
+	if err := mph.Mealplancase.DeleteTranslation(ctx, id, c.Param("locale")); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &utils.ResponseJSON{
+		Code:    http.StatusOK,
+		Message: "Plan translation deleted successfully",
+		Success: true,
+	})
+}
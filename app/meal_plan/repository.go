@@ -1,17 +1,29 @@
 package meal_plan
 
 import (
+	"context"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/iamaul/fatbellies/app/models"
 )
 
 // Repository represent the Meal Plan's repository contract
 type Repository interface {
-	Fetch(limit int64, offset int64, order string) (*[]models.MealPlan, error)
-	GetByID(id uuid.UUID) (models.MealPlan, error)
-	GetByName(name string) (models.MealPlan, error)
-	Store(plan *models.MealPlan) (*models.MealPlan, error)
-	Update(id uuid.UUID, plan models.MealPlan) (models.MealPlan, error)
-	Delete(id uuid.UUID) error
-	SearchPlans(column string, label string, order string) (*[]models.MealPlan, error)
+	Fetch(ctx context.Context, limit int64, offset int64, order string, includeDeleted bool) (*[]models.MealPlan, error)
+	GetByID(ctx context.Context, id uuid.UUID) (models.MealPlan, error)
+	GetByName(ctx context.Context, name string) (models.MealPlan, error)
+	List(ctx context.Context, limit int64, offset int64, order string, query string) ([]models.MealPlan, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]models.MealPlan, error)
+	BranchLinks(ctx context.Context, mealPlanIDs []uuid.UUID) ([]models.BranchMealPlan, error)
+	Store(ctx context.Context, plan *models.MealPlan, actor string) (*models.MealPlan, error)
+	Update(ctx context.Context, id uuid.UUID, version uint64, plan models.MealPlan, actor string) (models.MealPlan, error)
+	Patch(ctx context.Context, id uuid.UUID, version uint64, patch models.MealPlanPatch, actor string) (models.MealPlan, error)
+	Delete(ctx context.Context, id uuid.UUID, version uint64, actor string) error
+	Restore(ctx context.Context, id uuid.UUID, actor string) (models.MealPlan, error)
+	Purge(ctx context.Context, before time.Time) (int64, error)
+	Translations(ctx context.Context, mealPlanID uuid.UUID) ([]models.MealPlanTranslation, error)
+	StoreTranslation(ctx context.Context, translation *models.MealPlanTranslation) (*models.MealPlanTranslation, error)
+	DeleteTranslation(ctx context.Context, mealPlanID uuid.UUID, locale string) error
+	SearchPlans(ctx context.Context, column string, label string, order string) (*[]models.MealPlan, error)
 }
@@ -1,9 +1,16 @@
 package repository
 
 import (
+	"context"
+	"time"
+
 	"github.com/google/uuid"
+	"github.com/iamaul/fatbellies/app/events"
+	outbox "github.com/iamaul/fatbellies/app/events/repository"
 	mealPlan "github.com/iamaul/fatbellies/app/meal_plan"
 	"github.com/iamaul/fatbellies/app/models"
+	"github.com/iamaul/fatbellies/config/database"
+	"github.com/iamaul/fatbellies/utils"
 	"github.com/jinzhu/gorm"
 )
 
@@ -15,10 +22,19 @@ func NewMealPlanRepository(connection *gorm.DB) mealPlan.Repository {
 	return &mealPlanRepository{connection}
 }
 
-func (mpr *mealPlanRepository) Fetch(limit int64, offset int64, order string) (res *[]models.MealPlan, err error) {
+func (mpr *mealPlanRepository) conn(ctx context.Context) *gorm.DB {
+	return database.WithContext(ctx, mpr.Db)
+}
+
+func (mpr *mealPlanRepository) Fetch(ctx context.Context, limit int64, offset int64, order string, includeDeleted bool) (res *[]models.MealPlan, err error) {
 	plan := &[]models.MealPlan{}
 
-	if err = mpr.Db.Model(&models.MealPlan{}).Limit(limit).Offset(limit * (offset - 1)).Order(order).Preload("Branches").Find(&plan).Error; err != nil {
+	db := mpr.conn(ctx)
+	if includeDeleted {
+		db = db.Unscoped()
+	}
+
+	if err = db.Model(&models.MealPlan{}).Limit(limit).Offset(limit * (offset - 1)).Order(order).Preload("Branches").Preload("Branches.Translations").Preload("Translations").Find(&plan).Error; err != nil {
 		return
 	}
 
@@ -27,14 +43,14 @@ func (mpr *mealPlanRepository) Fetch(limit int64, offset int64, order string) (r
 	return
 }
 
-func (mpr *mealPlanRepository) GetByID(id uuid.UUID) (res models.MealPlan, err error) {
+func (mpr *mealPlanRepository) GetByID(ctx context.Context, id uuid.UUID) (res models.MealPlan, err error) {
 	plan := models.MealPlan{}
 
 	// ToDo: Redis cache get
 
-	if err = mpr.Db.Model(&models.MealPlan{}).Where("id = ?", id).Preload("Branches").First(&plan).Error; err != nil {
+	if err = mpr.conn(ctx).Model(&models.MealPlan{}).Where("id = ?", id).Preload("Branches").Preload("Branches.Translations").Preload("Translations").First(&plan).Error; err != nil {
 		if gorm.IsRecordNotFoundError(err) {
-			return
+			err = utils.ErrMealPlanNotFound
 		}
 		return
 	}
@@ -44,14 +60,14 @@ func (mpr *mealPlanRepository) GetByID(id uuid.UUID) (res models.MealPlan, err e
 	return
 }
 
-func (mpr *mealPlanRepository) GetByName(name string) (res models.MealPlan, err error) {
+func (mpr *mealPlanRepository) GetByName(ctx context.Context, name string) (res models.MealPlan, err error) {
 	plan := models.MealPlan{}
 
 	// ToDo: Redis cache get
 
-	if err = mpr.Db.Model(&models.MealPlan{}).Where("meal_plan_name = ?", name).Preload("Branches").First(&plan).Error; err != nil {
+	if err = mpr.conn(ctx).Model(&models.MealPlan{}).Where("meal_plan_name = ?", name).Preload("Branches").Preload("Branches.Translations").Preload("Translations").First(&plan).Error; err != nil {
 		if gorm.IsRecordNotFoundError(err) {
-			return
+			err = utils.ErrMealPlanNotFound
 		}
 		return
 	}
@@ -61,48 +77,171 @@ func (mpr *mealPlanRepository) GetByName(name string) (res models.MealPlan, err
 	return
 }
 
-func (mpr *mealPlanRepository) Store(plan *models.MealPlan) (res *models.MealPlan, err error) {
-	tx := mpr.Db.Begin()
-
-	if err := tx.Create(&plan).Error; err != nil {
-		tx.Rollback()
+// List returns a page of meal plans without their branches, only those whose
+// name contains query when it is set.
+func (mpr *mealPlanRepository) List(ctx context.Context, limit int64, offset int64, order string, query string) (res []models.MealPlan, err error) {
+	db := mpr.conn(ctx).Model(&models.MealPlan{})
+	if query != "" {
+		db = db.Where("meal_plan_name ILIKE ?", "%"+query+"%")
 	}
 
-	tx.Commit()
+	err = db.Limit(limit).Offset(limit * (offset - 1)).Order(order).Preload("Translations").Find(&res).Error
 
-	res = plan
+	return
+}
+
+// GetByIDs returns the meal plans with the given IDs with only their
+// translations
+func (mpr *mealPlanRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) (res []models.MealPlan, err error) {
+	err = mpr.conn(ctx).Model(&models.MealPlan{}).Where("id IN (?)", ids).Preload("Translations").Find(&res).Error
 
 	return
 }
 
-func (mpr *mealPlanRepository) Update(id uuid.UUID, newPlan models.MealPlan) (res models.MealPlan, err error) {
-	plan := models.MealPlan{}
+// BranchLinks returns the branch links of the given meal plans
+func (mpr *mealPlanRepository) BranchLinks(ctx context.Context, mealPlanIDs []uuid.UUID) (res []models.BranchMealPlan, err error) {
+	err = mpr.conn(ctx).Model(&models.BranchMealPlan{}).Where("meal_plan_id IN (?)", mealPlanIDs).Find(&res).Error
 
-	db := mpr.Db.Model(plan).Where("id = ?", id).UpdateColumns(newPlan)
-	if db.Error != nil {
-		if gorm.IsRecordNotFoundError(err) {
-			return
+	return
+}
+
+func (mpr *mealPlanRepository) Store(ctx context.Context, plan *models.MealPlan, actor string) (res *models.MealPlan, err error) {
+	err = mpr.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&plan).Error; err != nil {
+			return err
 		}
+
+		return outbox.Record(tx, actor, events.MealPlanCreated{MealPlan: *plan})
+	})
+	if err != nil {
 		return
 	}
 
-	newPlan, err = mpr.GetByID(id)
+	res = plan
+
+	return
+}
+
+// lock loads the meal plan into dest and holds its row until the transaction
+// ends. A version other than 0 must be the meal plan's current one.
+func lock(tx *gorm.DB, id uuid.UUID, version uint64, dest *models.MealPlan) error {
+	if err := tx.Set("gorm:query_option", "FOR UPDATE").Model(&models.MealPlan{}).Where("id = ?", id).First(dest).Error; err != nil {
+		return err
+	}
+
+	if version != 0 && dest.Version != version {
+		return utils.ErrPreconditionFailed
+	}
+
+	return nil
+}
+
+func (mpr *mealPlanRepository) Update(ctx context.Context, id uuid.UUID, version uint64, newPlan models.MealPlan, actor string) (res models.MealPlan, err error) {
+	before := models.MealPlan{}
+	plan := models.MealPlan{}
+
+	err = mpr.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lock(tx, id, version, &before); err != nil {
+			return err
+		}
+
+		newPlan.Version = 0
+		if err := tx.Model(&models.MealPlan{}).Where("id = ?", id).UpdateColumns(newPlan).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.MealPlan{}).Where("id = ?", id).UpdateColumn("version", gorm.Expr("version + 1")).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.MealPlan{}).Where("id = ?", id).Preload("Branches").First(&plan).Error; err != nil {
+			return err
+		}
+
+		return outbox.Record(tx, actor, events.MealPlanUpdated{Before: before, After: plan})
+	})
+	if gorm.IsRecordNotFoundError(err) {
+		err = utils.ErrMealPlanNotFound
+	}
 	if err != nil {
 		return
 	}
 
-	res = newPlan
+	res = plan
 
 	// ToDo: Redis cache update
 
 	return
 }
 
-func (mpr *mealPlanRepository) Delete(id uuid.UUID) (err error) {
-	if err = mpr.Db.Model(&models.MealPlan{}).Where("id = ?", id).Find(&models.MealPlan{}).Delete(&models.MealPlan{}).Error; err != nil {
-		if gorm.IsRecordNotFoundError(err) {
-			return
+// Patch writes every field of the patched meal plan, zero values included
+func (mpr *mealPlanRepository) Patch(ctx context.Context, id uuid.UUID, version uint64, patch models.MealPlanPatch, actor string) (res models.MealPlan, err error) {
+	before := models.MealPlan{}
+	plan := models.MealPlan{}
+
+	err = mpr.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lock(tx, id, version, &before); err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.MealPlan{}).Where("id = ?", id).UpdateColumns(map[string]interface{}{
+			"meal_plan_name": patch.MealPlanName,
+			"max_capacity":   patch.MaxCapacity,
+			"price":          patch.Price,
+			"day":            patch.Day,
+			"start_time":     patch.StartTime,
+			"end_time":       patch.EndTime,
+			"timezone":       patch.Timezone,
+			"updated_at":     time.Now(),
+			"version":        gorm.Expr("version + 1"),
+		}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.MealPlan{}).Where("id = ?", id).Preload("Branches").First(&plan).Error; err != nil {
+			return err
+		}
+
+		return outbox.Record(tx, actor, events.MealPlanUpdated{Before: before, After: plan})
+	})
+	if gorm.IsRecordNotFoundError(err) {
+		err = utils.ErrMealPlanNotFound
+	}
+	if err != nil {
+		err = utils.TranslateDBError(err)
+		return
+	}
+
+	res = plan
+
+	return
+}
+
+// Delete soft deletes the meal plan together with its branch links, stamping
+// them with the same time so Restore can bring back exactly those links.
+func (mpr *mealPlanRepository) Delete(ctx context.Context, id uuid.UUID, version uint64, actor string) (err error) {
+	err = mpr.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		plan := models.MealPlan{}
+		now := time.Now()
+
+		if err := lock(tx, id, version, &plan); err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.MealPlan{}).Where("id = ?", id).UpdateColumns(map[string]interface{}{"deleted_at": now, "version": gorm.Expr("version + 1")}).Error; err != nil {
+			return err
 		}
+
+		if err := tx.Model(&models.BranchMealPlan{}).Where("meal_plan_id = ?", id).UpdateColumn("deleted_at", now).Error; err != nil {
+			return err
+		}
+
+		return outbox.Record(tx, actor, events.MealPlanDeleted{MealPlan: plan})
+	})
+	if gorm.IsRecordNotFoundError(err) {
+		err = utils.ErrMealPlanNotFound
+	}
+	if err != nil {
 		return
 	}
 
@@ -111,10 +250,55 @@ func (mpr *mealPlanRepository) Delete(id uuid.UUID) (err error) {
 	return
 }
 
-func (mpr *mealPlanRepository) SearchPlans(column string, query string, order string) (res *[]models.MealPlan, err error) {
+func (mpr *mealPlanRepository) Restore(ctx context.Context, id uuid.UUID, actor string) (res models.MealPlan, err error) {
+	plan := models.MealPlan{}
+
+	err = mpr.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		deleted := models.MealPlan{}
+
+		if err := tx.Unscoped().Model(&models.MealPlan{}).Where("id = ? AND deleted_at IS NOT NULL", id).First(&deleted).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Model(&models.BranchMealPlan{}).Where("meal_plan_id = ? AND deleted_at = ?", id, deleted.DeletedAt).UpdateColumn("deleted_at", nil).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Model(&models.MealPlan{}).Where("id = ?", id).UpdateColumns(map[string]interface{}{"deleted_at": nil, "version": gorm.Expr("version + 1")}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.MealPlan{}).Where("id = ?", id).Preload("Branches").First(&plan).Error; err != nil {
+			return err
+		}
+
+		return outbox.Record(tx, actor, events.MealPlanRestored{MealPlan: plan, DeletedAt: deleted.DeletedAt})
+	})
+	if gorm.IsRecordNotFoundError(err) {
+		err = utils.ErrDeletedMealPlanNotFound
+	}
+	if err != nil {
+		return
+	}
+
+	res = plan
+
+	return
+}
+
+// Purge hard deletes meal plans soft deleted before the given time. Their
+// branch links go with them through ON DELETE CASCADE.
+func (mpr *mealPlanRepository) Purge(ctx context.Context, before time.Time) (purged int64, err error) {
+	db := mpr.conn(ctx).Unscoped().Where("deleted_at < ?", before).Delete(&models.MealPlan{})
+	purged, err = db.RowsAffected, db.Error
+
+	return
+}
+
+func (mpr *mealPlanRepository) SearchPlans(ctx context.Context, column string, query string, order string) (res *[]models.MealPlan, err error) {
 	plan := &[]models.MealPlan{}
 
-	if err = mpr.Db.Model(&models.MealPlan{}).Where(column+" ILIKE ?", "%"+query+"%").Order(order).Preload("Branches").Find(&plan).Error; err != nil {
+	if err = mpr.conn(ctx).Model(&models.MealPlan{}).Where(column+" ILIKE ?", "%"+query+"%").Order(order).Preload("Branches").Preload("Branches.Translations").Preload("Translations").Find(&plan).Error; err != nil {
 		return
 	}
 
@@ -122,3 +306,42 @@ func (mpr *mealPlanRepository) SearchPlans(column string, query string, order st
 
 	return
 }
+
+// Translations returns the translations of the meal plan ordered by locale
+func (mpr *mealPlanRepository) Translations(ctx context.Context, mealPlanID uuid.UUID) (res []models.MealPlanTranslation, err error) {
+	res = []models.MealPlanTranslation{}
+	err = mpr.conn(ctx).Model(&models.MealPlanTranslation{}).Where("meal_plan_id = ?", mealPlanID).Order("locale").Find(&res).Error
+
+	return
+}
+
+// StoreTranslation creates the translation of the meal plan in its locale,
+// or replaces the existing one
+func (mpr *mealPlanRepository) StoreTranslation(ctx context.Context, t *models.MealPlanTranslation) (res *models.MealPlanTranslation, err error) {
+	db := mpr.conn(ctx)
+
+	if err = db.Set("gorm:insert_option", "ON CONFLICT (meal_plan_id, locale) DO UPDATE SET meal_plan_name = EXCLUDED.meal_plan_name, updated_at = EXCLUDED.updated_at").Create(t).Error; err != nil {
+		err = utils.TranslateDBError(err)
+		return
+	}
+
+	// Reads the row back for the created_at of a replaced translation
+	res = &models.MealPlanTranslation{}
+	err = db.Where("meal_plan_id = ? AND locale = ?", t.MealPlanID, t.Locale).First(res).Error
+
+	return
+}
+
+// DeleteTranslation deletes the translation of the meal plan in locale
+func (mpr *mealPlanRepository) DeleteTranslation(ctx context.Context, mealPlanID uuid.UUID, locale string) error {
+	db := mpr.conn(ctx).Where("meal_plan_id = ? AND locale = ?", mealPlanID, locale).Delete(&models.MealPlanTranslation{})
+	if db.Error != nil {
+		return db.Error
+	}
+
+	if db.RowsAffected == 0 {
+		return utils.ErrTranslationNotFound
+	}
+
+	return nil
+}
@@ -1,25 +1,48 @@
 package usecase
 
 import (
+	"context"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 	mealPlan "github.com/iamaul/fatbellies/app/meal_plan"
 	"github.com/iamaul/fatbellies/app/models"
+	"github.com/iamaul/fatbellies/app/uow"
+	"github.com/iamaul/fatbellies/utils"
+)
+
+var (
+	// orders are the orders Fetch and SearchPlans accept
+	orders = utils.SortOrders("created_at", "updated_at", "meal_plan_name", "price", "max_capacity")
+
+	// searchColumns are the columns SearchPlans accepts and what each
+	// matches against
+	searchColumns = map[string]string{
+		"meal_plan_name": "meal_plan_name",
+		"day":            "day",
+		"price":          "price::text",
+	}
 )
 
 type mealPlanUsecase struct {
 	mealPlanRepo   mealPlan.Repository
+	unitOfWork     uow.UnitOfWork
 	contextTimeout time.Duration
 }
 
-func NewMealPlanUsecase(pr mealPlan.Repository) mealPlan.Usecase {
+func NewMealPlanUsecase(pr mealPlan.Repository, uw uow.UnitOfWork, timeout time.Duration) mealPlan.Usecase {
 	return &mealPlanUsecase{
-		mealPlanRepo: pr,
+		mealPlanRepo:   pr,
+		unitOfWork:     uw,
+		contextTimeout: timeout,
 	}
 }
 
-func (mpu *mealPlanUsecase) Fetch(limit int64, offset int64, order string) (*[]models.MealPlan, error) {
+func (mpu *mealPlanUsecase) Fetch(ctx context.Context, limit int64, offset int64, order string, includeDeleted bool) (*[]models.MealPlan, error) {
+	ctx, cancel := context.WithTimeout(ctx, mpu.contextTimeout)
+	defer cancel()
+
 	if limit == 0 {
 		limit = 10
 	}
@@ -28,50 +51,334 @@ func (mpu *mealPlanUsecase) Fetch(limit int64, offset int64, order string) (*[]m
 		order = "created_at desc"
 	}
 
-	res, err := mpu.mealPlanRepo.Fetch(limit, offset, order)
+	order, err := utils.SortOrder(order, orders)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := mpu.mealPlanRepo.Fetch(ctx, limit, offset, order, includeDeleted)
+
+	if res != nil {
+		for i := range *res {
+			localizeMealPlan(ctx, &(*res)[i])
+		}
+	}
 
 	return res, err
 }
 
-func (mpu *mealPlanUsecase) GetByID(id uuid.UUID) (models.MealPlan, error) {
-	res, err := mpu.mealPlanRepo.GetByID(id)
+func (mpu *mealPlanUsecase) GetByID(ctx context.Context, id uuid.UUID) (models.MealPlan, error) {
+	ctx, cancel := context.WithTimeout(ctx, mpu.contextTimeout)
+	defer cancel()
+
+	res, err := mpu.mealPlanRepo.GetByID(ctx, id)
+
+	localizeMealPlan(ctx, &res)
 
 	return res, err
 }
 
-func (mpu *mealPlanUsecase) GetByName(name string) (models.MealPlan, error) {
-	res, err := mpu.mealPlanRepo.GetByName(name)
+func (mpu *mealPlanUsecase) GetByName(ctx context.Context, name string) (models.MealPlan, error) {
+	ctx, cancel := context.WithTimeout(ctx, mpu.contextTimeout)
+	defer cancel()
+
+	res, err := mpu.mealPlanRepo.GetByName(ctx, name)
+
+	localizeMealPlan(ctx, &res)
 
 	return res, err
 }
 
-func (mpu *mealPlanUsecase) Store(branch *models.MealPlan) (*models.MealPlan, error) {
-	res, err := mpu.mealPlanRepo.Store(branch)
+func (mpu *mealPlanUsecase) List(ctx context.Context, limit int64, offset int64, order string, query string) ([]models.MealPlan, error) {
+	ctx, cancel := context.WithTimeout(ctx, mpu.contextTimeout)
+	defer cancel()
+
+	if limit == 0 {
+		limit = 10
+	}
+
+	if order == "" {
+		order = "created_at desc"
+	}
+
+	res, err := mpu.mealPlanRepo.List(ctx, limit, offset, order, query)
+
+	for i := range res {
+		localizeMealPlan(ctx, &res[i])
+	}
 
 	return res, err
 }
 
-func (mpu *mealPlanUsecase) Update(id uuid.UUID, branch models.MealPlan) (models.MealPlan, error) {
-	res, err := mpu.mealPlanRepo.Update(id, branch)
+func (mpu *mealPlanUsecase) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]models.MealPlan, error) {
+	ctx, cancel := context.WithTimeout(ctx, mpu.contextTimeout)
+	defer cancel()
+
+	res, err := mpu.mealPlanRepo.GetByIDs(ctx, ids)
+
+	for i := range res {
+		localizeMealPlan(ctx, &res[i])
+	}
 
 	return res, err
 }
 
-func (mpu *mealPlanUsecase) Delete(id uuid.UUID) (err error) {
-	err = mpu.mealPlanRepo.Delete(id)
+func (mpu *mealPlanUsecase) BranchLinks(ctx context.Context, mealPlanIDs []uuid.UUID) ([]models.BranchMealPlan, error) {
+	ctx, cancel := context.WithTimeout(ctx, mpu.contextTimeout)
+	defer cancel()
 
-	return err
+	res, err := mpu.mealPlanRepo.BranchLinks(ctx, mealPlanIDs)
+
+	return res, err
+}
+
+// Store creates the meal plan with its session in the timezone of the branch
+// it names, or in the default timezone
+func (mpu *mealPlanUsecase) Store(ctx context.Context, plan *models.MealPlan, actor string) (res *models.MealPlan, err error) {
+	ctx, cancel := context.WithTimeout(ctx, mpu.contextTimeout)
+	defer cancel()
+
+	err = mpu.unitOfWork.Do(ctx, func(r uow.Repositories) error {
+		if err := Schedule(ctx, r, plan, utils.DefaultTimezone); err != nil {
+			return err
+		}
+
+		res, err = r.MealPlans.Store(ctx, plan, actor)
+
+		return err
+	})
+
+	return
+}
+
+// Update replaces the meal plan. Its session moves to the timezone of the
+// branch it names, or stays in the meal plan's timezone.
+func (mpu *mealPlanUsecase) Update(ctx context.Context, id uuid.UUID, version uint64, plan models.MealPlan, actor string) (res models.MealPlan, err error) {
+	ctx, cancel := context.WithTimeout(ctx, mpu.contextTimeout)
+	defer cancel()
+
+	err = mpu.unitOfWork.Do(ctx, func(r uow.Repositories) error {
+		current, err := r.MealPlans.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := Schedule(ctx, r, &plan, current.Timezone); err != nil {
+			return err
+		}
+
+		res, err = r.MealPlans.Update(ctx, id, version, plan, actor)
+
+		return err
+	})
+
+	return
+}
+
+// Schedule checks the weekly session of the meal plan and settles the
+// timezone it is held in, the one of the branch named by BranchID or else
+// timezone. Sessions given as instants, as gRPC clients send them, are
+// taken as the local times of day they fall on in that timezone. Only the
+// weekday and local times are stored, the instants are resolved on read.
+func Schedule(ctx context.Context, r uow.Repositories, plan *models.MealPlan, timezone string) error {
+	if plan.BranchID != nil {
+		b, err := r.Branches.GetByID(ctx, *plan.BranchID)
+		if errors.Is(err, utils.ErrBranchNotFound) {
+			return utils.ErrUnknownBranch
+		}
+		if err != nil {
+			return err
+		}
+
+		timezone = b.Timezone
+	}
+
+	loc, err := utils.LoadTimezone(timezone)
+	if err != nil {
+		return err
+	}
+
+	if plan.StartClock == "" && plan.EndClock == "" {
+		if !plan.EndTime.After(plan.StartTime) {
+			return utils.InvalidRule("end_time", "gtfield", "start_time")
+		}
+
+		plan.StartClock = plan.StartTime.In(loc).Format("15:04:05")
+		plan.EndClock = plan.EndTime.In(loc).Format("15:04:05")
+	}
+
+	day, err := utils.CheckSession(plan.Day, plan.StartClock, plan.EndClock)
+	if err != nil {
+		return err
+	}
+
+	plan.Day = day.String()
+	plan.StartClock = utils.CanonicalClock(plan.StartClock)
+	plan.EndClock = utils.CanonicalClock(plan.EndClock)
+	plan.Timezone = timezone
+
+	return nil
+}
+
+func (mpu *mealPlanUsecase) Patch(ctx context.Context, id uuid.UUID, version uint64, patch []byte, actor string) (res models.MealPlan, err error) {
+	ctx, cancel := context.WithTimeout(ctx, mpu.contextTimeout)
+	defer cancel()
+
+	err = mpu.unitOfWork.Do(ctx, func(r uow.Repositories) error {
+		current, err := r.MealPlans.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		doc := models.NewMealPlanPatch(current)
+
+		touched, err := utils.MergePatch(&doc, patch)
+		if err != nil {
+			return err
+		}
+
+		if err := utils.ValidatePatch(&doc, touched); err != nil {
+			return err
+		}
+
+		if err := reschedule(&doc); err != nil {
+			return err
+		}
+
+		res, err = r.MealPlans.Patch(ctx, id, version, doc, actor)
+
+		return err
+	})
+
+	return
 }
 
-func (mpu *mealPlanUsecase) SearchPlans(column string, label string, order string) (*[]models.MealPlan, error) {
-	if column == "price" {
-		column = "price::text"
+// reschedule checks the patched session and spells its day and times the
+// way sessions are stored
+func reschedule(doc *models.MealPlanPatch) error {
+	day, err := utils.CheckSession(doc.Day, doc.StartTime, doc.EndTime)
+	if err != nil {
+		return err
 	}
+
+	doc.Day = day.String()
+	doc.StartTime = utils.CanonicalClock(doc.StartTime)
+	doc.EndTime = utils.CanonicalClock(doc.EndTime)
+
+	return nil
+}
+
+func (mpu *mealPlanUsecase) Delete(ctx context.Context, id uuid.UUID, version uint64, actor string) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, mpu.contextTimeout)
+	defer cancel()
+
+	err = mpu.mealPlanRepo.Delete(ctx, id, version, actor)
+
+	return err
+}
+
+func (mpu *mealPlanUsecase) Restore(ctx context.Context, id uuid.UUID, actor string) (models.MealPlan, error) {
+	ctx, cancel := context.WithTimeout(ctx, mpu.contextTimeout)
+	defer cancel()
+
+	res, err := mpu.mealPlanRepo.Restore(ctx, id, actor)
+
+	return res, err
+}
+
+func (mpu *mealPlanUsecase) Purge(ctx context.Context, retentionDays int) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, mpu.contextTimeout)
+	defer cancel()
+
+	res, err := mpu.mealPlanRepo.Purge(ctx, time.Now().AddDate(0, 0, -retentionDays))
+
+	return res, err
+}
+
+func (mpu *mealPlanUsecase) SearchPlans(ctx context.Context, column string, label string, order string) (*[]models.MealPlan, error) {
+	ctx, cancel := context.WithTimeout(ctx, mpu.contextTimeout)
+	defer cancel()
+
 	if order == "" {
 		order = "created_at desc"
 	}
 
-	res, err := mpu.mealPlanRepo.SearchPlans(column, label, order)
+	column, err := utils.SearchColumn(column, searchColumns)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err = utils.SortOrder(order, orders)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := mpu.mealPlanRepo.SearchPlans(ctx, column, label, order)
+
+	if res != nil {
+		for i := range *res {
+			localizeMealPlan(ctx, &(*res)[i])
+		}
+	}
 
 	return res, err
 }
+
+func (mpu *mealPlanUsecase) Translations(ctx context.Context, mealPlanID uuid.UUID) ([]models.MealPlanTranslation, error) {
+	ctx, cancel := context.WithTimeout(ctx, mpu.contextTimeout)
+	defer cancel()
+
+	if _, err := mpu.mealPlanRepo.GetByID(ctx, mealPlanID); err != nil {
+		return nil, err
+	}
+
+	res, err := mpu.mealPlanRepo.Translations(ctx, mealPlanID)
+
+	return res, err
+}
+
+// StoreTranslation creates or replaces the translation of the meal plan in the
+// locale of translation. The default locale is the meal plan's own content.
+func (mpu *mealPlanUsecase) StoreTranslation(ctx context.Context, translation *models.MealPlanTranslation) (*models.MealPlanTranslation, error) {
+	ctx, cancel := context.WithTimeout(ctx, mpu.contextTimeout)
+	defer cancel()
+
+	locale, err := utils.TranslationLocale(translation.Locale)
+	if err != nil {
+		return nil, err
+	}
+	translation.Locale = locale
+
+	if _, err := mpu.mealPlanRepo.GetByID(ctx, translation.MealPlanID); err != nil {
+		return nil, err
+	}
+
+	res, err := mpu.mealPlanRepo.StoreTranslation(ctx, translation)
+
+	return res, err
+}
+
+func (mpu *mealPlanUsecase) DeleteTranslation(ctx context.Context, mealPlanID uuid.UUID, locale string) error {
+	ctx, cancel := context.WithTimeout(ctx, mpu.contextTimeout)
+	defer cancel()
+
+	locale, err := utils.TranslationLocale(locale)
+	if err != nil {
+		return err
+	}
+
+	if _, err := mpu.mealPlanRepo.GetByID(ctx, mealPlanID); err != nil {
+		return err
+	}
+
+	return mpu.mealPlanRepo.DeleteTranslation(ctx, mealPlanID, locale)
+}
+
+// localizeMealPlan translates the meal plan into the language negotiated for the
+// request. Requests that negotiated none, such as gRPC calls, get the
+// meal plan as stored.
+func localizeMealPlan(ctx context.Context, plan *models.MealPlan) {
+	if locales := utils.LocalesFrom(ctx); locales != nil {
+		plan.Localize(locales, utils.DefaultLocale)
+	}
+}
@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iamaul/fatbellies/app/branch"
+	"github.com/iamaul/fatbellies/app/models"
+	"github.com/iamaul/fatbellies/app/uow"
+	"github.com/iamaul/fatbellies/utils"
+)
+
+// branchRepo serves the branches Schedule looks timezones up in
+type branchRepo struct {
+	branch.Repository
+	branches map[uuid.UUID]models.Branch
+}
+
+func (br branchRepo) GetByID(ctx context.Context, id uuid.UUID) (models.Branch, error) {
+	b, ok := br.branches[id]
+	if !ok {
+		return b, utils.ErrBranchNotFound
+	}
+
+	return b, nil
+}
+
+func TestSchedule(t *testing.T) {
+	nyBranch := uuid.New()
+	unknownBranch := uuid.New()
+	r := uow.Repositories{Branches: branchRepo{branches: map[uuid.UUID]models.Branch{
+		nyBranch: {ID: nyBranch, Timezone: "America/New_York"},
+	}}}
+
+	jakarta := time.FixedZone("WIB", 7*3600)
+
+	tests := []struct {
+		name      string
+		plan      models.MealPlan
+		timezone  string
+		wantDay   string
+		wantStart string
+		wantEnd   string
+		wantZone  string
+		wantErr   error
+		wantRule  string
+	}{
+		{
+			name:      "branch timezone",
+			plan:      models.MealPlan{Day: "sunday", StartClock: "02:30", EndClock: "04:00", BranchID: &nyBranch},
+			timezone:  utils.DefaultTimezone,
+			wantDay:   "Sunday",
+			wantStart: "02:30:00",
+			wantEnd:   "04:00:00",
+			wantZone:  "America/New_York",
+		},
+		{
+			name:      "overnight",
+			plan:      models.MealPlan{Day: "Saturday", StartClock: "22:00", EndClock: "02:00", BranchID: &nyBranch},
+			timezone:  utils.DefaultTimezone,
+			wantDay:   "Saturday",
+			wantStart: "22:00:00",
+			wantEnd:   "02:00:00",
+			wantZone:  "America/New_York",
+		},
+		{
+			name:      "without a branch, in the given timezone",
+			plan:      models.MealPlan{Day: "Sunday", StartClock: "18:30", EndClock: "21:00"},
+			timezone:  "Asia/Jakarta",
+			wantDay:   "Sunday",
+			wantStart: "18:30:00",
+			wantEnd:   "21:00:00",
+			wantZone:  "Asia/Jakarta",
+		},
+		{
+			name:     "unknown branch",
+			plan:     models.MealPlan{Day: "Sunday", StartClock: "18:30", EndClock: "21:00", BranchID: &unknownBranch},
+			timezone: utils.DefaultTimezone,
+			wantErr:  utils.ErrUnknownBranch,
+		},
+		{
+			name:     "not a weekday",
+			plan:     models.MealPlan{Day: "Everyday", StartClock: "18:30", EndClock: "21:00"},
+			timezone: utils.DefaultTimezone,
+			wantRule: "weekday",
+		},
+		{
+			name:     "malformed clock",
+			plan:     models.MealPlan{Day: "Sunday", StartClock: "18:30", EndClock: "9pm"},
+			timezone: utils.DefaultTimezone,
+			wantRule: "clock",
+		},
+		{
+			name:      "instants become local times of day",
+			plan:      models.MealPlan{Day: "Sunday", StartTime: time.Date(2026, 3, 8, 11, 0, 0, 0, time.UTC), EndTime: time.Date(2026, 3, 8, 13, 0, 0, 0, time.UTC)},
+			timezone:  "Asia/Jakarta",
+			wantDay:   "Sunday",
+			wantStart: "18:00:00",
+			wantEnd:   "20:00:00",
+			wantZone:  "Asia/Jakarta",
+		},
+		{
+			name:     "instants ending before they start",
+			plan:     models.MealPlan{Day: "Sunday", StartTime: time.Date(2026, 3, 8, 20, 0, 0, 0, jakarta), EndTime: time.Date(2026, 3, 8, 18, 0, 0, 0, jakarta)},
+			timezone: "Asia/Jakarta",
+			wantRule: "gtfield",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := tt.plan
+
+			err := Schedule(context.Background(), r, &plan, tt.timezone)
+
+			switch {
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			case tt.wantRule != "":
+				var e *utils.Error
+				if !errors.As(err, &e) || len(e.Fields) != 1 || e.Fields[0].Rule != tt.wantRule {
+					t.Fatalf("err = %v, want a %s failure", err, tt.wantRule)
+				}
+				return
+			case err != nil:
+				t.Fatal(err)
+			}
+
+			if plan.Day != tt.wantDay {
+				t.Errorf("day = %s, want %s", plan.Day, tt.wantDay)
+			}
+			if plan.StartClock != tt.wantStart || plan.EndClock != tt.wantEnd {
+				t.Errorf("session = %s - %s, want %s - %s", plan.StartClock, plan.EndClock, tt.wantStart, tt.wantEnd)
+			}
+			if plan.Timezone != tt.wantZone {
+				t.Errorf("timezone = %s, want %s", plan.Timezone, tt.wantZone)
+			}
+		})
+	}
+}
+
+func TestReschedule(t *testing.T) {
+	current := models.MealPlan{Day: "Sunday", StartClock: "18:00:00", EndClock: "20:00:00", Timezone: "America/New_York"}
+
+	t.Run("day and times spelled the way they are stored", func(t *testing.T) {
+		doc := models.NewMealPlanPatch(current)
+		doc.Day = " monday"
+		doc.EndTime = "21:30"
+		if err := reschedule(&doc); err != nil {
+			t.Fatal(err)
+		}
+
+		if doc.Day != "Monday" || doc.StartTime != "18:00:00" || doc.EndTime != "21:30:00" {
+			t.Errorf("session = %q %s - %s", doc.Day, doc.StartTime, doc.EndTime)
+		}
+	})
+
+	t.Run("not a weekday", func(t *testing.T) {
+		doc := models.NewMealPlanPatch(current)
+		doc.Day = "Everyday"
+
+		var e *utils.Error
+		if err := reschedule(&doc); !errors.As(err, &e) || e.Fields[0].Rule != "weekday" {
+			t.Errorf("err = %v, want a weekday failure", err)
+		}
+	})
+
+	t.Run("malformed patched clock", func(t *testing.T) {
+		doc := models.NewMealPlanPatch(current)
+		doc.EndTime = "20h"
+
+		var e *utils.Error
+		if err := reschedule(&doc); !errors.As(err, &e) || e.Fields[0].Rule != "clock" {
+			t.Errorf("err = %v, want a clock failure", err)
+		}
+	})
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AuditCreate  = "create"
+	AuditUpdate  = "update"
+	AuditDelete  = "delete"
+	AuditRestore = "restore"
+
+	AuditEntityBranch         = "branch"
+	AuditEntityBranchLocation = "branch_location"
+	AuditEntityMealPlan       = "meal_plan"
+	AuditEntityBranchMealPlan = "branch_meal_plan"
+)
+
+type AuditEntry struct {
+	ID        uuid.UUID       `gorm:"primary_key; type:uuid; default:uuid_generate_v4()" json:"id"`
+	EventID   uuid.UUID       `gorm:"type:uuid; not null; unique_index:idx_audit_entries_event_entity" json:"event_id"`
+	Actor     string          `gorm:"type:varchar(125); not null; index" json:"actor"`
+	Action    string          `gorm:"type:varchar(20); not null" json:"action"`
+	Entity    string          `gorm:"type:varchar(40); not null; index:idx_audit_entries_entity; unique_index:idx_audit_entries_event_entity" json:"entity"`
+	EntityID  string          `gorm:"type:varchar(80); not null; index:idx_audit_entries_entity; unique_index:idx_audit_entries_event_entity" json:"entity_id"`
+	Changes   json.RawMessage `gorm:"type:jsonb; not null" json:"changes" swaggertype:"object"`
+	CreatedAt time.Time       `gorm:"type:timestamp without time zone; default:CURRENT_TIMESTAMP; index" json:"created_at"`
+}
+
+// AuditFilter narrows down the audit log, zero values are ignored
+type AuditFilter struct {
+	Entity   string
+	EntityID string
+	Actor    string
+	From     time.Time
+	To       time.Time
+	Limit    int64
+	Page     int64
+}
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AvailabilityChange is pushed to clients watching a branch or a meal plan
+// session when what can be booked there may have changed. Only the fields
+// the change is about are set.
+type AvailabilityChange struct {
+	Event        string      `json:"event"`
+	BranchIDs    []uuid.UUID `json:"branch_ids" swaggertype:"array,string"`
+	MealPlanID   *uuid.UUID  `json:"meal_plan_id,omitempty" swaggertype:"string"`
+	MaxCapacity  *uint8      `json:"max_capacity,omitempty"`
+	Day          string      `json:"day,omitempty"`
+	StartTime    *time.Time  `json:"start_time,omitempty"`
+	EndTime      *time.Time  `json:"end_time,omitempty"`
+	OpeningHours *uint8      `json:"opening_hours,omitempty"`
+	OccurredAt   time.Time   `json:"occurred_at"`
+}
+
+// AvailabilityFilter selects the changes a client watches
+type AvailabilityFilter struct {
+	BranchIDs   []uuid.UUID
+	MealPlanIDs []uuid.UUID
+}
+
+// Matches reports whether the change concerns one of the watched branches or
+// meal plans
+func (f AvailabilityFilter) Matches(change AvailabilityChange) bool {
+	for _, id := range f.BranchIDs {
+		for _, branchID := range change.BranchIDs {
+			if id == branchID {
+				return true
+			}
+		}
+	}
+
+	if change.MealPlanID != nil {
+		for _, id := range f.MealPlanIDs {
+			if id == *change.MealPlanID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
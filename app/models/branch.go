@@ -7,14 +7,19 @@ import (
 )
 
 type Branch struct {
-	ID              uuid.UUID      `gorm:"primary_key; type:uuid; default:uuid_generate_v4()" json:"id"`
-	BranchName      string         `gorm:"type:varchar(125); null;" json:"branch_name" validate:"required,min=3"`
-	BranchLocations BranchLocation `json:"locations"`
-	OpeningHours    uint8          `gorm:"type:integer; default:0" json:"opening_hours" validate:"required,numeric"`
-	MealPlans       []MealPlan     `gorm:"many2many:branch_meal_plans;" json:"branch_meal_plans"`
-	CreatedAt       time.Time      `gorm:"type:timestamp without time zone; default:CURRENT_TIMESTAMP" json:"created_at"`
-	UpdatedAt       time.Time      `gorm:"type:timestamp without time zone; default:CURRENT_TIMESTAMP" json:"updated_at"`
-	DeletedAt       time.Time      `gorm:"type:timestamp without time zone; null; default:null" json:"deleted_at"`
+	ID              uuid.UUID           `gorm:"primary_key; type:uuid; default:uuid_generate_v4()" json:"id"`
+	BranchName      string              `gorm:"type:varchar(125); null;" json:"branch_name" validate:"required,min=3"`
+	BranchLocations BranchLocation      `json:"locations"`
+	OpeningHours    uint8               `gorm:"type:integer; default:0" json:"opening_hours" validate:"required,numeric"`
+	Timezone        string              `gorm:"type:varchar(64); not null; default:'Asia/Jakarta'" json:"timezone" validate:"omitempty,timezone"`
+	MealPlans       []MealPlan          `gorm:"many2many:branch_meal_plans;" json:"branch_meal_plans"`
+	MealPlanIDs     []uuid.UUID         `gorm:"-" json:"meal_plan_ids,omitempty"`
+	Translations    []BranchTranslation `gorm:"foreignkey:BranchID" json:"-"`
+	Locale          string              `gorm:"-" json:"locale,omitempty"`
+	Version         uint64              `gorm:"type:bigint; not null; default:1" json:"version"`
+	CreatedAt       time.Time           `gorm:"type:timestamp without time zone; default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt       time.Time           `gorm:"type:timestamp without time zone; default:CURRENT_TIMESTAMP" json:"updated_at"`
+	DeletedAt       *time.Time          `gorm:"type:timestamp without time zone; index" json:"deleted_at"`
 }
 
 type SwagBranchLocation struct {
@@ -26,4 +31,6 @@ type SwagBranch struct {
 	BranchName      string             `json:"branch_name"`
 	BranchLocations SwagBranchLocation `json:"locations"`
 	OpeningHours    uint8              `json:"opening_hours"`
+	Timezone        string             `json:"timezone" example:"Asia/Jakarta"`
+	MealPlanIDs     []string           `json:"meal_plan_ids"`
 }
@@ -1,8 +1,32 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type BranchMealPlan struct {
-	BranchID   uuid.UUID `json:"branch_id" validate:"required"`
-	MealPlanID uuid.UUID `json:"meal_plan_id" validate:"required"`
+	BranchID   uuid.UUID  `json:"branch_id" validate:"required"`
+	MealPlanID uuid.UUID  `json:"meal_plan_id" validate:"required"`
+	DeletedAt  *time.Time `gorm:"type:timestamp without time zone; index" json:"-"`
+}
+
+// MealPlanAssignment is the body of the bulk meal plan link endpoints
+type MealPlanAssignment struct {
+	MealPlanIDs []uuid.UUID `json:"meal_plan_ids" swaggertype:"array,string"`
+}
+
+// MealPlanCopy is the body of the meal plan lineup copy endpoint
+type MealPlanCopy struct {
+	BranchIDs []uuid.UUID `json:"branch_ids" validate:"required,min=1" swaggertype:"array,string"`
+}
+
+// MealPlanLinkChanges reports the links a bulk assignment made and removed
+// on a branch, along with the meal plans the branch ends up with.
+type MealPlanLinkChanges struct {
+	BranchID    uuid.UUID   `json:"branch_id"`
+	Linked      []uuid.UUID `json:"linked" swaggertype:"array,string"`
+	Unlinked    []uuid.UUID `json:"unlinked" swaggertype:"array,string"`
+	MealPlanIDs []uuid.UUID `json:"meal_plan_ids" swaggertype:"array,string"`
 }
@@ -0,0 +1,31 @@
+package models
+
+import "github.com/iamaul/fatbellies/utils"
+
+// Actions reported for each imported row
+const (
+	ImportCreate  = "create"
+	ImportUpdate  = "update"
+	ImportInvalid = "invalid"
+)
+
+// ImportRow reports what an import did, or would do, with one row. Row counts
+// data rows from 1, the header not included.
+type ImportRow struct {
+	Row    int                `json:"row"`
+	Name   string             `json:"name"`
+	Action string             `json:"action"`
+	Errors []utils.FieldError `json:"errors,omitempty"`
+}
+
+// ImportReport summarises an import. Nothing is written when DryRun is set or
+// any row is invalid, in which case Applied is false.
+type ImportReport struct {
+	DryRun  bool        `json:"dry_run"`
+	Applied bool        `json:"applied"`
+	Total   int         `json:"total"`
+	Created int         `json:"created"`
+	Updated int         `json:"updated"`
+	Invalid int         `json:"invalid"`
+	Rows    []ImportRow `json:"rows"`
+}
@@ -4,25 +4,76 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/iamaul/fatbellies/utils"
 )
 
 type MealPlan struct {
-	ID           uuid.UUID `gorm:"primary_key; type:uuid; default:uuid_generate_v4()" json:"id"`
-	MealPlanName string    `gorm:"type:varchar(150); null;" json:"meal_plan_name" validate:"required,min=3"`
-	MaxCapacity  uint8     `gorm:"type:integer; default:10" json:"max_capacity" validate:"required,numeric"`
-	Price        uint64    `gorm:"type:integer; default:5" json:"price" validate:"required,numeric"`
-	Day          string    `gorm:"type:varchar(40); null;" json:"day" validate:"required"`
-	StartTime    time.Time `gorm:"type:timestamp without time zone; null;" json:"start_time"`
-	EndTime      time.Time `gorm:"type:timestamp without time zone; null;" json:"end_time"`
-	Branches     []Branch  `gorm:"many2many:branch_meal_plans;" json:"branch_meal_plans"`
-	CreatedAt    time.Time `gorm:"type:timestamp without time zone; default:CURRENT_TIMESTAMP" json:"created_at"`
-	UpdatedAt    time.Time `gorm:"type:timestamp without time zone; default:CURRENT_TIMESTAMP" json:"updated_at"`
-	DeletedAt    time.Time `gorm:"type:timestamp without time zone; null; default:null" json:"deleted_at"`
+	ID           uuid.UUID             `gorm:"primary_key; type:uuid; default:uuid_generate_v4()" json:"id"`
+	MealPlanName string                `gorm:"type:varchar(150); null;" json:"meal_plan_name" validate:"required,min=3"`
+	MaxCapacity  uint8                 `gorm:"type:integer; default:10" json:"max_capacity" validate:"required,numeric"`
+	Price        uint64                `gorm:"type:integer; default:5" json:"price" validate:"required,numeric"`
+	Day          string                `gorm:"type:varchar(40); null;" json:"day" validate:"required,weekday"`
+	StartClock   string                `gorm:"column:start_time; type:varchar(8); null;" json:"start_clock"`
+	EndClock     string                `gorm:"column:end_time; type:varchar(8); null;" json:"end_clock"`
+	StartTime    time.Time             `gorm:"-" json:"start_time"`
+	EndTime      time.Time             `gorm:"-" json:"end_time"`
+	Timezone     string                `gorm:"type:varchar(64); not null; default:'Asia/Jakarta'" json:"timezone"`
+	BranchID     *uuid.UUID            `gorm:"-" json:"-"`
+	Branches     []Branch              `gorm:"many2many:branch_meal_plans;" json:"branch_meal_plans"`
+	Translations []MealPlanTranslation `gorm:"foreignkey:MealPlanID" json:"-"`
+	Locale       string                `gorm:"-" json:"locale,omitempty"`
+	Version      uint64                `gorm:"type:bigint; not null; default:1" json:"version"`
+	CreatedAt    time.Time             `gorm:"type:timestamp without time zone; default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt    time.Time             `gorm:"type:timestamp without time zone; default:CURRENT_TIMESTAMP" json:"updated_at"`
+	DeletedAt    *time.Time            `gorm:"type:timestamp without time zone; index" json:"deleted_at"`
 }
 
-type SwagMealPlan struct {
-	MealPlanName    string `json:"meal_plan_name"`
-	BranchLocations uint8  `json:"max_capacity"`
-	Price           uint64 `json:"price"`
-	Day             string `json:"day"`
+// MealPlanForm is the body creating or replacing a meal plan
+type MealPlanForm struct {
+	MealPlanName string     `json:"meal_plan_name" validate:"required,min=3"`
+	MaxCapacity  uint8      `json:"max_capacity" validate:"required,numeric"`
+	Price        uint64     `json:"price" validate:"required,numeric"`
+	Day          string     `json:"day" validate:"required,weekday" example:"Friday"`
+	BranchID     *uuid.UUID `json:"branch_id" swaggertype:"string"`
+	StartTime    string     `json:"start_time" validate:"required,clock" example:"18:30"`
+	EndTime      string     `json:"end_time" validate:"required,clock" example:"21:00"`
+}
+
+func (f MealPlanForm) MealPlan() MealPlan {
+	return MealPlan{
+		MealPlanName: f.MealPlanName,
+		MaxCapacity:  f.MaxCapacity,
+		Price:        f.Price,
+		Day:          f.Day,
+		StartClock:   f.StartTime,
+		EndClock:     f.EndTime,
+		BranchID:     f.BranchID,
+	}
+}
+
+// AfterFind resolves the session when a meal plan is read
+func (p *MealPlan) AfterFind() error {
+	p.Resolve(time.Now())
+	return nil
+}
+
+// AfterCreate resolves the session of a meal plan just stored
+func (p *MealPlan) AfterCreate() error {
+	p.Resolve(time.Now())
+	return nil
+}
+
+// Resolve sets StartTime and EndTime to the session held every Day from
+// StartClock to EndClock in the meal plan's timezone that is under way at
+// now, or else the next one. They are left as they are when the session
+// cannot be resolved.
+func (p *MealPlan) Resolve(now time.Time) {
+	loc, err := utils.LoadTimezone(p.Timezone)
+	if err != nil {
+		return
+	}
+
+	if startsAt, endsAt, err := utils.NextSession(p.Day, p.StartClock, p.EndClock, loc, now); err == nil {
+		p.StartTime, p.EndTime = startsAt, endsAt
+	}
 }
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	NotificationPending = "pending"
+	NotificationSent    = "sent"
+	NotificationFailed  = "failed"
+)
+
+type Notification struct {
+	ID            uuid.UUID  `gorm:"primary_key; type:uuid; default:uuid_generate_v4()" json:"id"`
+	Event         string     `gorm:"type:varchar(60); not null" json:"event"`
+	Channel       string     `gorm:"type:varchar(20); not null" json:"channel"`
+	Locale        string     `gorm:"type:varchar(10); not null" json:"locale"`
+	Recipient     string     `gorm:"type:varchar(255); not null" json:"recipient"`
+	Subject       string     `gorm:"type:varchar(255)" json:"subject"`
+	Body          string     `gorm:"type:text" json:"body"`
+	Status        string     `gorm:"type:varchar(20); default:'pending'; index" json:"status"`
+	Attempts      int        `gorm:"type:integer; default:0" json:"attempts"`
+	NextAttemptAt time.Time  `gorm:"type:timestamp without time zone; default:CURRENT_TIMESTAMP; index" json:"next_attempt_at"`
+	LastError     string     `gorm:"type:text" json:"last_error,omitempty"`
+	SentAt        *time.Time `gorm:"type:timestamp without time zone; null" json:"sent_at"`
+	CreatedAt     time.Time  `gorm:"type:timestamp without time zone; default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt     time.Time  `gorm:"type:timestamp without time zone; default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
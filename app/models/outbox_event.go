@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OutboxEvent struct {
+	ID            uuid.UUID  `gorm:"primary_key; type:uuid; default:uuid_generate_v4()" json:"id"`
+	Name          string     `gorm:"type:varchar(60); not null" json:"name"`
+	Payload       string     `gorm:"type:jsonb; not null" json:"payload"`
+	Actor         string     `gorm:"type:varchar(125); not null; default:''" json:"actor"`
+	OccurredAt    time.Time  `gorm:"type:timestamp without time zone; default:CURRENT_TIMESTAMP" json:"occurred_at"`
+	Attempts      int        `gorm:"type:integer; default:0" json:"attempts"`
+	NextAttemptAt time.Time  `gorm:"type:timestamp without time zone; default:CURRENT_TIMESTAMP; index" json:"next_attempt_at"`
+	LastError     string     `gorm:"type:text" json:"last_error,omitempty"`
+	PublishedAt   *time.Time `gorm:"type:timestamp without time zone; null; index" json:"published_at"`
+}
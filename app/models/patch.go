@@ -0,0 +1,52 @@
+package models
+
+// BranchPatch is the document a JSON merge patch of a branch is applied to.
+// Members missing from it, like the ID, cannot be patched.
+type BranchPatch struct {
+	BranchName   string        `json:"branch_name" validate:"required,min=3"`
+	OpeningHours uint8         `json:"opening_hours" validate:"numeric"`
+	Timezone     string        `json:"timezone" validate:"required,timezone"`
+	Locations    LocationPatch `json:"locations"`
+}
+
+type LocationPatch struct {
+	Latitude  float64 `json:"latitude" validate:"numeric"`
+	Longitude float64 `json:"longitude" validate:"numeric"`
+}
+
+func NewBranchPatch(b Branch) BranchPatch {
+	return BranchPatch{
+		BranchName:   b.BranchName,
+		OpeningHours: b.OpeningHours,
+		Timezone:     b.Timezone,
+		Locations: LocationPatch{
+			Latitude:  b.BranchLocations.Latitude,
+			Longitude: b.BranchLocations.Longitude,
+		},
+	}
+}
+
+// MealPlanPatch is the document a JSON merge patch of a meal plan is applied
+// to. The session is patched as local times of day in the meal plan's
+// timezone, which follows its branch and cannot be patched by clients.
+type MealPlanPatch struct {
+	MealPlanName string `json:"meal_plan_name" validate:"required,min=3"`
+	MaxCapacity  uint8  `json:"max_capacity" validate:"required,numeric"`
+	Price        uint64 `json:"price" validate:"required,numeric"`
+	Day          string `json:"day" validate:"required,weekday"`
+	StartTime    string `json:"start_time" validate:"required,clock"`
+	EndTime      string `json:"end_time" validate:"required,clock"`
+	Timezone     string `json:"-"`
+}
+
+func NewMealPlanPatch(p MealPlan) MealPlanPatch {
+	return MealPlanPatch{
+		MealPlanName: p.MealPlanName,
+		MaxCapacity:  p.MaxCapacity,
+		Price:        p.Price,
+		Day:          p.Day,
+		StartTime:    p.StartClock,
+		EndTime:      p.EndClock,
+		Timezone:     p.Timezone,
+	}
+}
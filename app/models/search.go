@@ -0,0 +1,31 @@
+package models
+
+import (
+	"github.com/google/uuid"
+)
+
+const (
+	SearchTypeBranch   = "branch"
+	SearchTypeMealPlan = "meal_plan"
+)
+
+// SearchTypes lists the kinds of result search can return
+var SearchTypes = []string{SearchTypeBranch, SearchTypeMealPlan}
+
+// SearchResult is one ranked hit of the unified search. Snippet is the
+// matched text with the matching words wrapped in <mark> tags.
+type SearchResult struct {
+	Type    string    `json:"type"`
+	ID      uuid.UUID `json:"id"`
+	Title   string    `json:"title"`
+	Snippet string    `json:"snippet"`
+	Rank    float64   `json:"rank"`
+}
+
+// SearchQuery is what the unified search looks for, zero values are ignored
+type SearchQuery struct {
+	Query string
+	Types []string
+	Limit int64
+	Page  int64
+}
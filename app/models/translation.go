@@ -0,0 +1,82 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BranchTranslation is the content of a branch in one locale. The branch's
+// own fields hold the content in the default locale.
+type BranchTranslation struct {
+	BranchID   uuid.UUID `gorm:"primary_key; type:uuid" json:"branch_id"`
+	Locale     string    `gorm:"primary_key; type:varchar(35)" json:"locale"`
+	BranchName string    `gorm:"type:varchar(125); not null" json:"branch_name" validate:"required,min=3"`
+	CreatedAt  time.Time `gorm:"type:timestamp without time zone; default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt  time.Time `gorm:"type:timestamp without time zone; default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// MealPlanTranslation is the content of a meal plan in one locale
+type MealPlanTranslation struct {
+	MealPlanID   uuid.UUID `gorm:"primary_key; type:uuid" json:"meal_plan_id"`
+	Locale       string    `gorm:"primary_key; type:varchar(35)" json:"locale"`
+	MealPlanName string    `gorm:"type:varchar(150); not null" json:"meal_plan_name" validate:"required,min=3"`
+	CreatedAt    time.Time `gorm:"type:timestamp without time zone; default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt    time.Time `gorm:"type:timestamp without time zone; default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+type SwagBranchTranslation struct {
+	BranchName string `json:"branch_name"`
+}
+
+type SwagMealPlanTranslation struct {
+	MealPlanName string `json:"meal_plan_name"`
+}
+
+// Localize replaces the branch's content, and that of its meal plans, with
+// the first translation found along locales. defaultLocale stands for the
+// base content, so the search stops there. Locale is set to the locale the
+// content ends up in.
+func (b *Branch) Localize(locales []string, defaultLocale string) {
+	for i := range b.MealPlans {
+		b.MealPlans[i].Localize(locales, defaultLocale)
+	}
+
+	b.Locale = defaultLocale
+	for _, locale := range locales {
+		if locale == defaultLocale {
+			return
+		}
+
+		for _, t := range b.Translations {
+			if t.Locale == locale {
+				b.BranchName = t.BranchName
+				b.Locale = locale
+				return
+			}
+		}
+	}
+}
+
+// Localize replaces the meal plan's content, and that of its branches, with
+// the first translation found along locales, like Branch.Localize
+func (p *MealPlan) Localize(locales []string, defaultLocale string) {
+	for i := range p.Branches {
+		p.Branches[i].Localize(locales, defaultLocale)
+	}
+
+	p.Locale = defaultLocale
+	for _, locale := range locales {
+		if locale == defaultLocale {
+			return
+		}
+
+		for _, t := range p.Translations {
+			if t.Locale == locale {
+				p.MealPlanName = t.MealPlanName
+				p.Locale = locale
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const (
+	EventBranchCreated    = "branch.created"
+	EventBranchUpdated    = "branch.updated"
+	EventBranchDeleted    = "branch.deleted"
+	EventBranchRestored   = "branch.restored"
+	EventMealPlanCreated  = "mealplan.created"
+	EventMealPlanUpdated  = "mealplan.updated"
+	EventMealPlanDeleted  = "mealplan.deleted"
+	EventMealPlanRestored = "mealplan.restored"
+	EventMealPlanLinked   = "mealplan.linked"
+	EventMealPlanUnlinked = "mealplan.unlinked"
+
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// WebhookEvents lists the event types partners can subscribe to
+var WebhookEvents = []string{
+	EventBranchCreated,
+	EventBranchUpdated,
+	EventBranchDeleted,
+	EventBranchRestored,
+	EventMealPlanCreated,
+	EventMealPlanUpdated,
+	EventMealPlanDeleted,
+	EventMealPlanRestored,
+	EventMealPlanLinked,
+	EventMealPlanUnlinked,
+}
+
+type WebhookSubscription struct {
+	ID         uuid.UUID      `gorm:"primary_key; type:uuid; default:uuid_generate_v4()" json:"id"`
+	URL        string         `gorm:"type:varchar(255); not null" json:"url" validate:"required,url"`
+	Secret     string         `gorm:"type:varchar(255); not null" json:"secret,omitempty" validate:"required,min=16"`
+	EventTypes pq.StringArray `gorm:"type:text[]" json:"event_types" validate:"required,min=1" swaggertype:"array,string"`
+	Active     bool           `gorm:"default:true" json:"active"`
+	CreatedAt  time.Time      `gorm:"type:timestamp without time zone; default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt  time.Time      `gorm:"type:timestamp without time zone; default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+type WebhookDelivery struct {
+	ID             uuid.UUID           `gorm:"primary_key; type:uuid; default:uuid_generate_v4()" json:"id"`
+	SubscriptionID uuid.UUID           `gorm:"type:uuid; not null; index" json:"subscription_id"`
+	Subscription   WebhookSubscription `json:"-"`
+	EventID        uuid.UUID           `gorm:"type:uuid; not null" json:"event_id"`
+	EventType      string              `gorm:"type:varchar(60); not null" json:"event_type"`
+	Payload        string              `gorm:"type:jsonb; not null" json:"payload"`
+	Status         string              `gorm:"type:varchar(20); default:'pending'; index" json:"status"`
+	Attempts       int                 `gorm:"type:integer; default:0" json:"attempts"`
+	NextAttemptAt  time.Time           `gorm:"type:timestamp without time zone; default:CURRENT_TIMESTAMP; index" json:"next_attempt_at"`
+	ResponseStatus int                 `gorm:"type:integer; default:0" json:"response_status"`
+	LastError      string              `gorm:"type:text" json:"last_error,omitempty"`
+	DeliveredAt    *time.Time          `gorm:"type:timestamp without time zone; null" json:"delivered_at"`
+	CreatedAt      time.Time           `gorm:"type:timestamp without time zone; default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt      time.Time           `gorm:"type:timestamp without time zone; default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+type SwagWebhookSubscription struct {
+	URL        string   `json:"url"`
+	Secret     string   `json:"secret"`
+	EventTypes []string `json:"event_types"`
+}
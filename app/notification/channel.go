@@ -0,0 +1,9 @@
+package notification
+
+import "github.com/iamaul/fatbellies/app/models"
+
+// Channel represent a delivery channel (email, sms, push, ...) for notifications
+type Channel interface {
+	Name() string
+	Send(n *models.Notification) error
+}
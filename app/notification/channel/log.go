@@ -0,0 +1,48 @@
+package channel
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/iamaul/fatbellies/app/models"
+	"github.com/iamaul/fatbellies/app/notification"
+)
+
+type logChannel struct {
+	name string
+	mu   sync.Mutex
+	out  io.Writer
+}
+
+// NewLogChannel writes notifications to a local file instead of a real
+// provider. An empty path writes to stdout.
+func NewLogChannel(name string, path string) (notification.Channel, error) {
+	var out io.Writer = os.Stdout
+
+	if path != "" {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return nil, err
+		}
+		out = f
+	}
+
+	return &logChannel{name: name, out: out}, nil
+}
+
+func (lc *logChannel) Name() string {
+	return lc.name
+}
+
+func (lc *logChannel) Send(n *models.Notification) error {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	_, err := fmt.Fprintf(lc.out, "[%s] channel=%s event=%s locale=%s to=%s\nSubject: %s\n\n%s\n\n",
+		time.Now().Format(time.RFC3339), lc.name, n.Event, n.Locale, n.Recipient, n.Subject, n.Body)
+
+	return err
+}
@@ -0,0 +1,67 @@
+package channel
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/iamaul/fatbellies/app/models"
+	"github.com/iamaul/fatbellies/app/notification"
+)
+
+type pushChannel struct {
+	apiURL    string
+	serverKey string
+	client    *http.Client
+}
+
+// NewPushChannel sends push notifications through an FCM compatible HTTP
+// endpoint, the recipient being the device token.
+func NewPushChannel(apiURL string, serverKey string) notification.Channel {
+	return &pushChannel{
+		apiURL:    apiURL,
+		serverKey: serverKey,
+		client:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (pc *pushChannel) Name() string {
+	return "push"
+}
+
+func (pc *pushChannel) Send(n *models.Notification) error {
+	payload, err := json.Marshal(map[string]interface{}{
+		"to": n.Recipient,
+		"notification": map[string]string{
+			"title": n.Subject,
+			"body":  n.Body,
+		},
+		"data": map[string]string{
+			"event": n.Event,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, pc.apiURL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "key="+pc.serverKey)
+
+	res, err := pc.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("push provider responded with %s", res.Status)
+	}
+
+	return nil
+}
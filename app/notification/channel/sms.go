@@ -0,0 +1,60 @@
+package channel
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/iamaul/fatbellies/app/models"
+	"github.com/iamaul/fatbellies/app/notification"
+)
+
+type smsChannel struct {
+	apiURL string
+	apiKey string
+	sender string
+	client *http.Client
+}
+
+// NewSMSChannel sends text messages through an HTTP SMS gateway that
+// accepts form encoded `to`, `from` and `body` fields.
+func NewSMSChannel(apiURL string, apiKey string, sender string) notification.Channel {
+	return &smsChannel{
+		apiURL: apiURL,
+		apiKey: apiKey,
+		sender: sender,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (sc *smsChannel) Name() string {
+	return "sms"
+}
+
+func (sc *smsChannel) Send(n *models.Notification) error {
+	form := url.Values{}
+	form.Set("to", n.Recipient)
+	form.Set("from", sc.sender)
+	form.Set("body", n.Body)
+
+	req, err := http.NewRequest(http.MethodPost, sc.apiURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", "Bearer "+sc.apiKey)
+
+	res, err := sc.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("sms gateway responded with %s", res.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package channel
+
+import (
+	"fmt"
+	"net/smtp"
+	"strings"
+
+	"github.com/iamaul/fatbellies/app/models"
+	"github.com/iamaul/fatbellies/app/notification"
+)
+
+type smtpChannel struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+func NewSMTPChannel(host string, port string, username string, password string, from string) notification.Channel {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &smtpChannel{
+		addr: fmt.Sprintf("%s:%s", host, port),
+		auth: auth,
+		from: from,
+	}
+}
+
+func (sc *smtpChannel) Name() string {
+	return "email"
+}
+
+func (sc *smtpChannel) Send(n *models.Notification) error {
+	var msg strings.Builder
+
+	fmt.Fprintf(&msg, "From: %s\r\n", sc.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", n.Recipient)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", n.Subject)
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n\r\n")
+	msg.WriteString(n.Body)
+
+	return smtp.SendMail(sc.addr, sc.auth, sc.from, []string{n.Recipient}, []byte(msg.String()))
+}
@@ -0,0 +1,17 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iamaul/fatbellies/app/models"
+)
+
+// Repository represent the notification outbox contract
+type Repository interface {
+	Store(ctx context.Context, n *models.Notification) (*models.Notification, error)
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) (*[]models.Notification, error)
+	MarkSent(ctx context.Context, id uuid.UUID) error
+	MarkFailed(ctx context.Context, id uuid.UUID, attempts int, nextAttemptAt time.Time, reason string, final bool) error
+}
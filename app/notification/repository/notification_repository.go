@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iamaul/fatbellies/app/models"
+	"github.com/iamaul/fatbellies/app/notification"
+	"github.com/iamaul/fatbellies/config/database"
+	"github.com/jinzhu/gorm"
+)
+
+type notificationRepository struct {
+	Db *gorm.DB
+}
+
+func NewNotificationRepository(connection *gorm.DB) notification.Repository {
+	return &notificationRepository{connection}
+}
+
+func (nr *notificationRepository) conn(ctx context.Context) *gorm.DB {
+	return database.WithContext(ctx, nr.Db)
+}
+
+func (nr *notificationRepository) Store(ctx context.Context, n *models.Notification) (res *models.Notification, err error) {
+	if err = nr.conn(ctx).Create(n).Error; err != nil {
+		return
+	}
+
+	res = n
+
+	return
+}
+
+// ClaimDue locks pending notifications that are due and pushes their next
+// attempt forward by lease, so other replicas skip them while they are sent.
+func (nr *notificationRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration) (res *[]models.Notification, err error) {
+	notifications := &[]models.Notification{}
+	now := time.Now()
+
+	tx := nr.conn(ctx).Begin()
+
+	if err = tx.Set("gorm:query_option", "FOR UPDATE SKIP LOCKED").
+		Where("status = ? AND next_attempt_at <= ?", models.NotificationPending, now).
+		Order("next_attempt_at").Limit(limit).Find(notifications).Error; err != nil {
+		tx.Rollback()
+		return
+	}
+
+	ids := make([]uuid.UUID, 0, len(*notifications))
+	for _, n := range *notifications {
+		ids = append(ids, n.ID)
+	}
+
+	if len(ids) > 0 {
+		if err = tx.Model(&models.Notification{}).Where("id IN (?)", ids).
+			UpdateColumn("next_attempt_at", now.Add(lease)).Error; err != nil {
+			tx.Rollback()
+			return
+		}
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		return
+	}
+
+	res = notifications
+
+	return
+}
+
+func (nr *notificationRepository) MarkSent(ctx context.Context, id uuid.UUID) (err error) {
+	now := time.Now()
+
+	err = nr.conn(ctx).Model(&models.Notification{}).Where("id = ?", id).UpdateColumns(map[string]interface{}{
+		"status":     models.NotificationSent,
+		"sent_at":    now,
+		"last_error": "",
+		"updated_at": now,
+	}).Error
+
+	return
+}
+
+func (nr *notificationRepository) MarkFailed(ctx context.Context, id uuid.UUID, attempts int, nextAttemptAt time.Time, reason string, final bool) (err error) {
+	status := models.NotificationPending
+	if final {
+		status = models.NotificationFailed
+	}
+
+	err = nr.conn(ctx).Model(&models.Notification{}).Where("id = ?", id).UpdateColumns(map[string]interface{}{
+		"status":          status,
+		"attempts":        attempts,
+		"next_attempt_at": nextAttemptAt,
+		"last_error":      reason,
+		"updated_at":      time.Now(),
+	}).Error
+
+	return
+}
@@ -0,0 +1,119 @@
+package templates
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+const (
+	ReservationConfirmed = "reservation.confirmed"
+	ReservationReminder  = "reservation.reminder"
+	ReservationCancelled = "reservation.cancelled"
+	WaitlistOffer        = "waitlist.offer"
+
+	DefaultLocale = "en"
+)
+
+type content struct {
+	Subject string
+	Body    string
+}
+
+// catalog holds the message content per event type and locale
+var catalog = map[string]map[string]content{
+	ReservationConfirmed: {
+		"en": {
+			Subject: "Your reservation at {{.branch_name}} is confirmed",
+			Body:    "Hi {{.customer_name}}, your table for {{.meal_plan_name}} at {{.branch_name}} on {{.session_time}} is confirmed. See you there!",
+		},
+		"id": {
+			Subject: "Reservasi Anda di {{.branch_name}} telah dikonfirmasi",
+			Body:    "Halo {{.customer_name}}, meja Anda untuk {{.meal_plan_name}} di {{.branch_name}} pada {{.session_time}} telah dikonfirmasi. Sampai jumpa!",
+		},
+	},
+	ReservationReminder: {
+		"en": {
+			Subject: "Reminder: {{.meal_plan_name}} at {{.branch_name}}",
+			Body:    "Hi {{.customer_name}}, this is a reminder that your buffet session {{.meal_plan_name}} at {{.branch_name}} starts on {{.session_time}}.",
+		},
+		"id": {
+			Subject: "Pengingat: {{.meal_plan_name}} di {{.branch_name}}",
+			Body:    "Halo {{.customer_name}}, sesi buffet {{.meal_plan_name}} Anda di {{.branch_name}} dimulai pada {{.session_time}}.",
+		},
+	},
+	ReservationCancelled: {
+		"en": {
+			Subject: "Your reservation at {{.branch_name}} was cancelled",
+			Body:    "Hi {{.customer_name}}, your reservation for {{.meal_plan_name}} at {{.branch_name}} on {{.session_time}} has been cancelled.",
+		},
+		"id": {
+			Subject: "Reservasi Anda di {{.branch_name}} dibatalkan",
+			Body:    "Halo {{.customer_name}}, reservasi Anda untuk {{.meal_plan_name}} di {{.branch_name}} pada {{.session_time}} telah dibatalkan.",
+		},
+	},
+	WaitlistOffer: {
+		"en": {
+			Subject: "A seat opened up at {{.branch_name}}",
+			Body:    "Hi {{.customer_name}}, a seat is now available for {{.meal_plan_name}} at {{.branch_name}} on {{.session_time}}. Claim it before {{.expires_at}}.",
+		},
+		"id": {
+			Subject: "Kursi tersedia di {{.branch_name}}",
+			Body:    "Halo {{.customer_name}}, kursi untuk {{.meal_plan_name}} di {{.branch_name}} pada {{.session_time}} kini tersedia. Klaim sebelum {{.expires_at}}.",
+		},
+	},
+}
+
+// Render builds the subject and body of an event for the given locale,
+// falling back to the base language and then to DefaultLocale.
+func Render(event string, locale string, data map[string]interface{}) (subject string, body string, err error) {
+	locales, ok := catalog[event]
+	if !ok {
+		err = fmt.Errorf("no template for event %q", event)
+		return
+	}
+
+	c, ok := locales[resolveLocale(locales, locale)]
+	if !ok {
+		err = fmt.Errorf("no template for event %q", event)
+		return
+	}
+
+	if subject, err = execute(c.Subject, data); err != nil {
+		return
+	}
+
+	body, err = execute(c.Body, data)
+
+	return
+}
+
+func resolveLocale(locales map[string]content, locale string) string {
+	locale = strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+	if _, ok := locales[locale]; ok {
+		return locale
+	}
+
+	if i := strings.Index(locale, "-"); i > 0 {
+		if _, ok := locales[locale[:i]]; ok {
+			return locale[:i]
+		}
+	}
+
+	return DefaultLocale
+}
+
+func execute(text string, data map[string]interface{}) (string, error) {
+	tmpl, err := template.New("").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
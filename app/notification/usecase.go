@@ -0,0 +1,13 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/iamaul/fatbellies/app/models"
+)
+
+// Usecase represent the notification's usecases
+type Usecase interface {
+	Enqueue(ctx context.Context, event string, channel string, locale string, recipient string, data map[string]interface{}) (*models.Notification, error)
+	Dispatch() error
+}
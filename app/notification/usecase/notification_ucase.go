@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/iamaul/fatbellies/app/models"
+	"github.com/iamaul/fatbellies/app/notification"
+	"github.com/iamaul/fatbellies/app/notification/templates"
+	"github.com/iamaul/fatbellies/utils"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	dispatchBatchSize = 50
+	dispatchLease     = 2 * time.Minute
+	maxAttempts       = 8
+)
+
+type notificationUsecase struct {
+	notificationRepo notification.Repository
+	channels         map[string]notification.Channel
+}
+
+func NewNotificationUsecase(nr notification.Repository, channels ...notification.Channel) notification.Usecase {
+	registry := make(map[string]notification.Channel, len(channels))
+	for _, ch := range channels {
+		registry[ch.Name()] = ch
+	}
+
+	return &notificationUsecase{
+		notificationRepo: nr,
+		channels:         registry,
+	}
+}
+
+func (nu *notificationUsecase) Enqueue(ctx context.Context, event string, channel string, locale string, recipient string, data map[string]interface{}) (*models.Notification, error) {
+	if _, ok := nu.channels[channel]; !ok {
+		return nil, fmt.Errorf("unknown notification channel %q", channel)
+	}
+
+	if locale == "" {
+		locale = templates.DefaultLocale
+	}
+
+	subject, body, err := templates.Render(event, locale, data)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := nu.notificationRepo.Store(ctx, &models.Notification{
+		Event:         event,
+		Channel:       channel,
+		Locale:        locale,
+		Recipient:     recipient,
+		Subject:       subject,
+		Body:          body,
+		Status:        models.NotificationPending,
+		NextAttemptAt: time.Now(),
+	})
+
+	return res, err
+}
+
+// Dispatch sends one batch of due notifications from the outbox. Failed sends
+// are rescheduled with exponential backoff until maxAttempts is reached.
+func (nu *notificationUsecase) Dispatch() error {
+	ctx := context.Background()
+
+	due, err := nu.notificationRepo.ClaimDue(ctx, dispatchBatchSize, dispatchLease)
+	if err != nil {
+		return err
+	}
+
+	for i := range *due {
+		n := &(*due)[i]
+
+		err := nu.send(n)
+		if err == nil {
+			if err := nu.notificationRepo.MarkSent(ctx, n.ID); err != nil {
+				logrus.Error(err)
+			}
+			continue
+		}
+
+		attempts := n.Attempts + 1
+		final := attempts >= maxAttempts
+
+		logrus.WithFields(logrus.Fields{
+			"notification": n.ID,
+			"channel":      n.Channel,
+			"attempt":      attempts,
+		}).Warn(err)
+
+		if err := nu.notificationRepo.MarkFailed(ctx, n.ID, attempts, time.Now().Add(utils.Backoff(attempts)), err.Error(), final); err != nil {
+			logrus.Error(err)
+		}
+	}
+
+	return nil
+}
+
+func (nu *notificationUsecase) send(n *models.Notification) error {
+	ch, ok := nu.channels[n.Channel]
+	if !ok {
+		return fmt.Errorf("unknown notification channel %q", n.Channel)
+	}
+
+	return ch.Send(n)
+}
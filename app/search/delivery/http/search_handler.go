@@ -0,0 +1,69 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/iamaul/fatbellies/app/models"
+	"github.com/iamaul/fatbellies/app/search"
+	"github.com/iamaul/fatbellies/utils"
+	"github.com/labstack/echo/v4"
+)
+
+type SearchHandler struct {
+	Searchcase search.Usecase
+}
+
+func NewSearchHandler(e *echo.Echo, su search.Usecase) {
+	handler := &SearchHandler{
+		Searchcase: su,
+	}
+
+	v1 := e.Group("/api/v1")
+	v1.GET("/search", handler.Search)
+}
+
+// @Summary Search branches and meal plans
+// @Description Full text search with typo tolerance across branches and meal plans, best matches first. Matching words in the snippet are wrapped in <mark> tags.
+// @Tags Search
+// @Accept  json
+// @Produce  json
+// @Param q query string true "Search terms, at least 2 characters"
+// @Param type query string false "Comma separated result types: branch, meal_plan"
+// @Param limit query integer 20 "limit numbers"
+// @Param page query integer 1 "pagination, up to the page holding the 1000th result"
+// @Success 200 {array} models.SearchResult
+// @Router /search [get]
+func (sh *SearchHandler) Search(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	queryLimit := c.QueryParam("limit")
+	limit, _ := strconv.Atoi(queryLimit)
+	queryPage := c.QueryParam("page")
+	page, _ := strconv.Atoi(queryPage)
+
+	query := models.SearchQuery{
+		Query: c.QueryParam("q"),
+		Limit: int64(limit),
+		Page:  int64(page),
+	}
+
+	if types := c.QueryParam("type"); types != "" {
+		for _, t := range strings.Split(types, ",") {
+			query.Types = append(query.Types, strings.TrimSpace(t))
+		}
+	}
+
+	res, err := sh.Searchcase.Search(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &utils.ResponseJSON{
+		Code:    http.StatusOK,
+		Result:  res,
+		Message: "Searched data successfully",
+		Success: true,
+	})
+}
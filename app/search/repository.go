@@ -0,0 +1,12 @@
+package search
+
+import (
+	"context"
+
+	"github.com/iamaul/fatbellies/app/models"
+)
+
+// Repository represent the search's repository contract
+type Repository interface {
+	Search(ctx context.Context, query models.SearchQuery) ([]models.SearchResult, error)
+}
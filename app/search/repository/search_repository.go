@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"html"
+	"strings"
+
+	"github.com/iamaul/fatbellies/app/models"
+	"github.com/iamaul/fatbellies/app/search"
+	"github.com/iamaul/fatbellies/config/database"
+	"github.com/jinzhu/gorm"
+)
+
+// Postgres marks matches with these, they are swapped for <mark> tags once the
+// rest of the snippet is escaped
+const (
+	startSel = "\x02"
+	stopSel  = "\x03"
+)
+
+// sources holds one ranked query per result type. A row matches on the full
+// text vector, weighted by field, or, to tolerate typos, on trigram word
+// similarity with the name.
+var sources = map[string]string{
+	models.SearchTypeBranch: `
+SELECT 'branch' AS type, id, branch_name AS title,
+	ts_headline('simple', branch_name, q.tsq, q.opts) AS snippet,
+	ts_rank(search_vector, q.tsq) + word_similarity(q.text, branch_name) AS rank
+FROM branches, q
+WHERE deleted_at IS NULL AND (search_vector @@ q.tsq OR q.text <% branch_name)`,
+	models.SearchTypeMealPlan: `
+SELECT 'meal_plan' AS type, id, meal_plan_name AS title,
+	ts_headline('simple', concat_ws(' ', meal_plan_name, day), q.tsq, q.opts) AS snippet,
+	ts_rank(search_vector, q.tsq) + word_similarity(q.text, meal_plan_name) AS rank
+FROM meal_plans, q
+WHERE deleted_at IS NULL AND (search_vector @@ q.tsq OR q.text <% meal_plan_name)`,
+}
+
+type searchRepository struct {
+	Db *gorm.DB
+}
+
+func NewSearchRepository(connection *gorm.DB) search.Repository {
+	return &searchRepository{connection}
+}
+
+func (sr *searchRepository) conn(ctx context.Context) *gorm.DB {
+	return database.WithContext(ctx, sr.Db)
+}
+
+// Search ranks branches and meal plans against the query in one statement.
+// Soft deleted rows are left out.
+func (sr *searchRepository) Search(ctx context.Context, query models.SearchQuery) (res []models.SearchResult, err error) {
+	var unions []string
+	for _, t := range query.Types {
+		unions = append(unions, sources[t])
+	}
+
+	sql := `
+WITH q AS (
+	SELECT websearch_to_tsquery('simple', ?) AS tsq, ?::text AS text, ?::text AS opts
+)
+SELECT * FROM (` + strings.Join(unions, "\nUNION ALL") + `
+) AS results
+ORDER BY rank DESC, title, id
+LIMIT ? OFFSET ?`
+
+	opts := "StartSel=" + startSel + ", StopSel=" + stopSel + ", HighlightAll=true"
+
+	err = sr.conn(ctx).Raw(sql, query.Query, query.Query, opts, query.Limit, query.Limit*(query.Page-1)).Scan(&res).Error
+	if err != nil {
+		return
+	}
+
+	for i := range res {
+		res[i].Snippet = highlight(res[i].Snippet)
+	}
+
+	return
+}
+
+func highlight(snippet string) string {
+	snippet = html.EscapeString(snippet)
+	snippet = strings.ReplaceAll(snippet, startSel, "<mark>")
+
+	return strings.ReplaceAll(snippet, stopSel, "</mark>")
+}
@@ -0,0 +1,12 @@
+package search
+
+import (
+	"context"
+
+	"github.com/iamaul/fatbellies/app/models"
+)
+
+// Usecase represent the search's usecases
+type Usecase interface {
+	Search(ctx context.Context, query models.SearchQuery) ([]models.SearchResult, error)
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/iamaul/fatbellies/app/models"
+	"github.com/iamaul/fatbellies/app/search"
+	"github.com/iamaul/fatbellies/utils"
+)
+
+const (
+	minQueryLength = 2
+	maxLimit       = 100
+	// maxResults is how deep results can be paged. Past the best matches
+	// ranking is noise, and large offsets make Postgres rank and skip every
+	// match before them.
+	maxResults = 1000
+)
+
+type searchUsecase struct {
+	searchRepo     search.Repository
+	contextTimeout time.Duration
+}
+
+func NewSearchUsecase(sr search.Repository, timeout time.Duration) search.Usecase {
+	return &searchUsecase{
+		searchRepo:     sr,
+		contextTimeout: timeout,
+	}
+}
+
+func (su *searchUsecase) Search(ctx context.Context, query models.SearchQuery) ([]models.SearchResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, su.contextTimeout)
+	defer cancel()
+
+	query.Query = strings.TrimSpace(query.Query)
+	if len([]rune(query.Query)) < minQueryLength {
+		return nil, utils.InvalidField("q", "min", "q must be at least 2 characters")
+	}
+
+	if len(query.Types) == 0 {
+		query.Types = models.SearchTypes
+	}
+
+	types := make([]string, 0, len(query.Types))
+	seen := make(map[string]bool, len(query.Types))
+	for _, t := range query.Types {
+		if !isSearchType(t) {
+			return nil, utils.InvalidField("type", "oneof", "type must be one of ["+strings.Join(models.SearchTypes, " ")+"]")
+		}
+		if !seen[t] {
+			seen[t] = true
+			types = append(types, t)
+		}
+	}
+	query.Types = types
+
+	if query.Limit <= 0 {
+		query.Limit = 20
+	}
+
+	if query.Limit > maxLimit {
+		query.Limit = maxLimit
+	}
+
+	if query.Page <= 0 {
+		query.Page = 1
+	}
+
+	if maxPage := maxResults / query.Limit; query.Page > maxPage {
+		return nil, utils.InvalidRule("page", "max", strconv.FormatInt(maxPage, 10))
+	}
+
+	res, err := su.searchRepo.Search(ctx, query)
+
+	return res, err
+}
+
+func isSearchType(t string) bool {
+	for _, known := range models.SearchTypes {
+		if t == known {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+
+	br "github.com/iamaul/fatbellies/app/branch/repository"
+	mpr "github.com/iamaul/fatbellies/app/meal_plan/repository"
+	"github.com/iamaul/fatbellies/app/uow"
+	"github.com/iamaul/fatbellies/config/database"
+	"github.com/jinzhu/gorm"
+)
+
+type unitOfWork struct {
+	Db *gorm.DB
+}
+
+func NewUnitOfWork(connection *gorm.DB) uow.UnitOfWork {
+	return &unitOfWork{connection}
+}
+
+// Do hands fn repositories built on one transaction. The repositories' own
+// Transaction calls join it instead of committing on their own, so their
+// writes and outbox events land or roll back together.
+func (u *unitOfWork) Do(ctx context.Context, fn func(r uow.Repositories) error) error {
+	return database.WithContext(ctx, u.Db).Transaction(func(tx *gorm.DB) error {
+		return fn(uow.Repositories{
+			Branches:  br.NewBranchRepository(tx),
+			MealPlans: mpr.NewMealPlanRepository(tx),
+		})
+	})
+}
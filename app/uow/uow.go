@@ -0,0 +1,21 @@
+package uow
+
+import (
+	"context"
+
+	"github.com/iamaul/fatbellies/app/branch"
+	mealPlan "github.com/iamaul/fatbellies/app/meal_plan"
+)
+
+// Repositories are the repositories bound to a single unit of work
+type Repositories struct {
+	Branches  branch.Repository
+	MealPlans mealPlan.Repository
+}
+
+// UnitOfWork represent the contract for running several repository
+// operations atomically. Do commits when fn returns nil and rolls back and
+// returns fn's error otherwise, or when ctx is done first.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(r Repositories) error) error
+}
@@ -0,0 +1,229 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/iamaul/fatbellies/app/models"
+	"github.com/iamaul/fatbellies/app/webhook"
+	"github.com/iamaul/fatbellies/utils"
+	"github.com/labstack/echo/v4"
+)
+
+type WebhookHandler struct {
+	Webhookcase webhook.Usecase
+}
+
+func NewWebhookHandler(e *echo.Echo, wu webhook.Usecase) {
+	handler := &WebhookHandler{
+		Webhookcase: wu,
+	}
+
+	v1 := e.Group("/api/v1")
+	v1.GET("/webhooks", handler.Fetch)
+	v1.POST("/webhooks", handler.Store)
+	v1.GET("/webhooks/:id", handler.GetByID)
+	v1.DELETE("/webhooks/:id", handler.Delete)
+	v1.GET("/webhooks/:id/deliveries", handler.FetchDeliveries)
+	v1.POST("/webhooks/deliveries/:id/redeliver", handler.Redeliver)
+
+	// Deprecated aliases of the v1 routes, served until utils.LegacySunset
+	g := e.Group("/api")
+	g.GET("/webhooks", handler.Fetch, utils.Deprecated("/api/v1/webhooks"))
+	g.GET("/webhooks/:id", handler.GetByID, utils.Deprecated("/api/v1/webhooks/:id"))
+	g.POST("/webhooks", handler.Store, utils.Deprecated("/api/v1/webhooks"))
+	g.DELETE("/delete/webhooks/:id", handler.Delete, utils.Deprecated("/api/v1/webhooks/:id"))
+	g.GET("/webhooks/:id/deliveries", handler.FetchDeliveries, utils.Deprecated("/api/v1/webhooks/:id/deliveries"))
+	g.POST("/webhooks/deliveries/:id/redeliver", handler.Redeliver, utils.Deprecated("/api/v1/webhooks/deliveries/:id/redeliver"))
+}
+
+// @Summary List webhook subscriptions
+// @Description Get a list of webhook subscriptions. Secrets are left out, they are only returned when a subscription is added.
+// @Tags Webhooks
+// @Accept  json
+// @Produce  json
+// @Success 200 {array} models.WebhookSubscription
+// @Router /webhooks [get]
+func (wh *WebhookHandler) Fetch(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	res, err := wh.Webhookcase.Fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &utils.ResponseJSON{
+		Code:    http.StatusOK,
+		Result:  res,
+		Message: "Fetched data successfully",
+		Success: true,
+	})
+}
+
+// @Summary Find one of all the webhook subscriptions
+// @Description Get webhook subscription by ID, without its secret
+// @Tags Webhooks
+// @Accept  json
+// @Produce  json
+// @Param id path string uuid "Webhook ID"
+// @Success 200 {object} models.WebhookSubscription
+// @Router /webhooks/{id} [get]
+func (wh *WebhookHandler) GetByID(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return utils.ErrInvalidID.Wrap(err)
+	}
+
+	res, err := wh.Webhookcase.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &utils.ResponseJSON{
+		Code:    http.StatusOK,
+		Result:  res,
+		Message: "Fetched data by id",
+		Success: true,
+	})
+}
+
+func createWebhookValidation(cw *models.WebhookSubscription) (bool, error) {
+	validate := utils.NewValidator()
+
+	err := validate.Struct(cw)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// @Summary Add webhook subscription
+// @Description Subscribe an https URL of a public host to branch and meal plan events. Deliveries are not sent to private, loopback or link-local addresses, nor follow redirects. The response is the only one carrying the signing secret.
+// @Tags Webhooks
+// @Accept  json
+// @Produce  json
+// @Param webhook body models.SwagWebhookSubscription true "Form JSON"
+// @Success 201 {object} models.WebhookSubscription
+// @Router /webhooks [post]
+func (wh *WebhookHandler) Store(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var sub models.WebhookSubscription
+
+	err := c.Bind(&sub)
+	if err != nil {
+		return utils.ErrInvalidBody.Wrap(err)
+	}
+
+	if ok, err := createWebhookValidation(&sub); !ok {
+		return utils.Validation(err)
+	}
+
+	res, err := wh.Webhookcase.Store(ctx, &sub)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, &utils.ResponseJSON{
+		Code:    http.StatusCreated,
+		Result:  res,
+		Message: "Webhook created successfully",
+		Success: true,
+	})
+}
+
+// @Summary Delete one of all the webhook subscriptions
+// @Description Delete webhook subscription and its delivery log by ID
+// @Tags Webhooks
+// @Accept  json
+// @Produce  json
+// @Param id path string false "Webhook ID"
+// @Success 200
+// @Router /webhooks/{id} [delete]
+func (wh *WebhookHandler) Delete(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return utils.ErrInvalidID.Wrap(err)
+	}
+
+	err = wh.Webhookcase.Delete(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &utils.ResponseJSON{
+		Code:    http.StatusOK,
+		Message: "Webhook deleted successfully",
+		Success: true,
+	})
+}
+
+// @Summary List webhook deliveries
+// @Description Get the delivery log of a webhook subscription. Only the status endpoints responded with is kept, not their response.
+// @Tags Webhooks
+// @Accept  json
+// @Produce  json
+// @Param id path string uuid "Webhook ID"
+// @Param limit query integer 20 "limit numbers"
+// @Param page query integer 1 "pagination"
+// @Success 200 {array} models.WebhookDelivery
+// @Router /webhooks/{id}/deliveries [get]
+func (wh *WebhookHandler) FetchDeliveries(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return utils.ErrInvalidID.Wrap(err)
+	}
+
+	queryLimit := c.QueryParam("limit")
+	limit, _ := strconv.Atoi(queryLimit)
+	queryPage := c.QueryParam("page")
+	page, _ := strconv.Atoi(queryPage)
+
+	res, err := wh.Webhookcase.FetchDeliveries(ctx, id, int64(limit), int64(page))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &utils.ResponseJSON{
+		Code:    http.StatusOK,
+		Result:  res,
+		Message: "Fetched data successfully",
+		Success: true,
+	})
+}
+
+// @Summary Redeliver webhook
+// @Description Queue a new delivery of the same event payload
+// @Tags Webhooks
+// @Accept  json
+// @Produce  json
+// @Param id path string uuid "Delivery ID"
+// @Success 202 {object} models.WebhookDelivery
+// @Router /webhooks/deliveries/{id}/redeliver [post]
+func (wh *WebhookHandler) Redeliver(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return utils.ErrInvalidID.Wrap(err)
+	}
+
+	res, err := wh.Webhookcase.Redeliver(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusAccepted, &utils.ResponseJSON{
+		Code:    http.StatusAccepted,
+		Result:  res,
+		Message: "Webhook redelivery queued",
+		Success: true,
+	})
+}
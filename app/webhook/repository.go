@@ -0,0 +1,24 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iamaul/fatbellies/app/models"
+)
+
+// Repository represent the webhook's repository contract
+type Repository interface {
+	Fetch(ctx context.Context) (*[]models.WebhookSubscription, error)
+	GetByID(ctx context.Context, id uuid.UUID) (models.WebhookSubscription, error)
+	FindByEvent(ctx context.Context, eventType string) (*[]models.WebhookSubscription, error)
+	Store(ctx context.Context, sub *models.WebhookSubscription) (*models.WebhookSubscription, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	HasDelivery(ctx context.Context, subscriptionID uuid.UUID, eventID uuid.UUID) (bool, error)
+	StoreDelivery(ctx context.Context, delivery *models.WebhookDelivery) (*models.WebhookDelivery, error)
+	GetDelivery(ctx context.Context, id uuid.UUID) (models.WebhookDelivery, error)
+	FetchDeliveries(ctx context.Context, subscriptionID uuid.UUID, limit int64, offset int64) (*[]models.WebhookDelivery, error)
+	ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) (*[]models.WebhookDelivery, error)
+	UpdateDelivery(ctx context.Context, id uuid.UUID, fields map[string]interface{}) error
+}
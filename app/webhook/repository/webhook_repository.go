@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iamaul/fatbellies/app/models"
+	"github.com/iamaul/fatbellies/app/webhook"
+	"github.com/iamaul/fatbellies/config/database"
+	"github.com/iamaul/fatbellies/utils"
+	"github.com/jinzhu/gorm"
+)
+
+type webhookRepository struct {
+	Db *gorm.DB
+}
+
+func NewWebhookRepository(connection *gorm.DB) webhook.Repository {
+	return &webhookRepository{connection}
+}
+
+func (wr *webhookRepository) conn(ctx context.Context) *gorm.DB {
+	return database.WithContext(ctx, wr.Db)
+}
+
+func (wr *webhookRepository) Fetch(ctx context.Context) (res *[]models.WebhookSubscription, err error) {
+	subs := &[]models.WebhookSubscription{}
+
+	if err = wr.conn(ctx).Model(&models.WebhookSubscription{}).Order("created_at desc").Find(subs).Error; err != nil {
+		return
+	}
+
+	res = subs
+
+	return
+}
+
+func (wr *webhookRepository) GetByID(ctx context.Context, id uuid.UUID) (res models.WebhookSubscription, err error) {
+	sub := models.WebhookSubscription{}
+
+	if err = wr.conn(ctx).Model(&models.WebhookSubscription{}).Where("id = ?", id).First(&sub).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			err = utils.ErrWebhookNotFound
+		}
+		return
+	}
+
+	res = sub
+
+	return
+}
+
+func (wr *webhookRepository) FindByEvent(ctx context.Context, eventType string) (res *[]models.WebhookSubscription, err error) {
+	subs := &[]models.WebhookSubscription{}
+
+	if err = wr.conn(ctx).Model(&models.WebhookSubscription{}).Where("active = ? AND ? = ANY(event_types)", true, eventType).Find(subs).Error; err != nil {
+		return
+	}
+
+	res = subs
+
+	return
+}
+
+func (wr *webhookRepository) Store(ctx context.Context, sub *models.WebhookSubscription) (res *models.WebhookSubscription, err error) {
+	if err = wr.conn(ctx).Create(sub).Error; err != nil {
+		return
+	}
+
+	res = sub
+
+	return
+}
+
+func (wr *webhookRepository) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	err = wr.conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("subscription_id = ?", id).Delete(&models.WebhookDelivery{}).Error; err != nil {
+			return err
+		}
+
+		db := tx.Where("id = ?", id).Delete(&models.WebhookSubscription{})
+		if db.Error != nil {
+			return db.Error
+		}
+
+		if db.RowsAffected == 0 {
+			return utils.ErrWebhookNotFound
+		}
+
+		return nil
+	})
+
+	return
+}
+
+func (wr *webhookRepository) HasDelivery(ctx context.Context, subscriptionID uuid.UUID, eventID uuid.UUID) (exists bool, err error) {
+	var count int
+
+	if err = wr.conn(ctx).Model(&models.WebhookDelivery{}).Where("subscription_id = ? AND event_id = ?", subscriptionID, eventID).Count(&count).Error; err != nil {
+		return
+	}
+
+	exists = count > 0
+
+	return
+}
+
+func (wr *webhookRepository) StoreDelivery(ctx context.Context, delivery *models.WebhookDelivery) (res *models.WebhookDelivery, err error) {
+	if err = wr.conn(ctx).Create(delivery).Error; err != nil {
+		return
+	}
+
+	res = delivery
+
+	return
+}
+
+func (wr *webhookRepository) GetDelivery(ctx context.Context, id uuid.UUID) (res models.WebhookDelivery, err error) {
+	delivery := models.WebhookDelivery{}
+
+	if err = wr.conn(ctx).Model(&models.WebhookDelivery{}).Where("id = ?", id).First(&delivery).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			err = utils.ErrWebhookDeliveryNotFound
+		}
+		return
+	}
+
+	res = delivery
+
+	return
+}
+
+func (wr *webhookRepository) FetchDeliveries(ctx context.Context, subscriptionID uuid.UUID, limit int64, offset int64) (res *[]models.WebhookDelivery, err error) {
+	deliveries := &[]models.WebhookDelivery{}
+
+	if err = wr.conn(ctx).Model(&models.WebhookDelivery{}).Where("subscription_id = ?", subscriptionID).
+		Limit(limit).Offset(limit * (offset - 1)).Order("created_at desc").Find(deliveries).Error; err != nil {
+		return
+	}
+
+	res = deliveries
+
+	return
+}
+
+// ClaimDueDeliveries locks pending deliveries that are due and pushes their
+// next attempt forward by lease, so other replicas skip them while in flight.
+func (wr *webhookRepository) ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) (res *[]models.WebhookDelivery, err error) {
+	deliveries := &[]models.WebhookDelivery{}
+	now := time.Now()
+
+	tx := wr.conn(ctx).Begin()
+
+	if err = tx.Set("gorm:query_option", "FOR UPDATE SKIP LOCKED").
+		Where("status = ? AND next_attempt_at <= ?", models.WebhookDeliveryPending, now).
+		Order("next_attempt_at").Limit(limit).Find(deliveries).Error; err != nil {
+		tx.Rollback()
+		return
+	}
+
+	ids := make([]uuid.UUID, 0, len(*deliveries))
+	for _, d := range *deliveries {
+		ids = append(ids, d.ID)
+	}
+
+	if len(ids) > 0 {
+		if err = tx.Model(&models.WebhookDelivery{}).Where("id IN (?)", ids).
+			UpdateColumn("next_attempt_at", now.Add(lease)).Error; err != nil {
+			tx.Rollback()
+			return
+		}
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		return
+	}
+
+	if len(ids) > 0 {
+		if err = wr.conn(ctx).Model(&models.WebhookDelivery{}).Where("id IN (?)", ids).Preload("Subscription").Find(deliveries).Error; err != nil {
+			return
+		}
+	}
+
+	res = deliveries
+
+	return
+}
+
+func (wr *webhookRepository) UpdateDelivery(ctx context.Context, id uuid.UUID, fields map[string]interface{}) (err error) {
+	fields["updated_at"] = time.Now()
+
+	err = wr.conn(ctx).Model(&models.WebhookDelivery{}).Where("id = ?", id).UpdateColumns(fields).Error
+
+	return
+}
@@ -0,0 +1,20 @@
+package webhook
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/iamaul/fatbellies/app/events"
+	"github.com/iamaul/fatbellies/app/models"
+)
+
+// Usecase represent the webhook's usecases
+type Usecase interface {
+	Fetch(ctx context.Context) (*[]models.WebhookSubscription, error)
+	GetByID(ctx context.Context, id uuid.UUID) (models.WebhookSubscription, error)
+	Store(ctx context.Context, sub *models.WebhookSubscription) (*models.WebhookSubscription, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	FetchDeliveries(ctx context.Context, subscriptionID uuid.UUID, limit int64, offset int64) (*[]models.WebhookDelivery, error)
+	Redeliver(ctx context.Context, deliveryID uuid.UUID) (*models.WebhookDelivery, error)
+	HandleEvent(env events.Envelope) error
+	Dispatch() error
+}
@@ -0,0 +1,304 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iamaul/fatbellies/app/events"
+	"github.com/iamaul/fatbellies/app/models"
+	"github.com/iamaul/fatbellies/app/webhook"
+	"github.com/iamaul/fatbellies/utils"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	dispatchBatchSize = 50
+	dispatchLease     = 2 * time.Minute
+	maxAttempts       = 10
+	maxResponseBody   = 2048
+)
+
+type webhookUsecase struct {
+	webhookRepo    webhook.Repository
+	client         *http.Client
+	allowHTTP      bool
+	contextTimeout time.Duration
+}
+
+// NewWebhookUsecase returns the webhook usecase. Subscriptions must use
+// https unless allowHTTP is set, and are only ever called on public
+// addresses.
+func NewWebhookUsecase(wr webhook.Repository, allowHTTP bool, timeout time.Duration) webhook.Usecase {
+	return &webhookUsecase{
+		webhookRepo:    wr,
+		client:         utils.OutboundClient(10 * time.Second),
+		allowHTTP:      allowHTTP,
+		contextTimeout: timeout,
+	}
+}
+
+// Fetch returns the subscriptions without their secrets, which are only
+// shown once when a subscription is stored
+func (wu *webhookUsecase) Fetch(ctx context.Context) (*[]models.WebhookSubscription, error) {
+	ctx, cancel := context.WithTimeout(ctx, wu.contextTimeout)
+	defer cancel()
+
+	res, err := wu.webhookRepo.Fetch(ctx)
+
+	if res != nil {
+		for i := range *res {
+			(*res)[i].Secret = ""
+		}
+	}
+
+	return res, err
+}
+
+// GetByID returns the subscription without its secret
+func (wu *webhookUsecase) GetByID(ctx context.Context, id uuid.UUID) (models.WebhookSubscription, error) {
+	ctx, cancel := context.WithTimeout(ctx, wu.contextTimeout)
+	defer cancel()
+
+	res, err := wu.webhookRepo.GetByID(ctx, id)
+	res.Secret = ""
+
+	return res, err
+}
+
+func (wu *webhookUsecase) Store(ctx context.Context, sub *models.WebhookSubscription) (*models.WebhookSubscription, error) {
+	ctx, cancel := context.WithTimeout(ctx, wu.contextTimeout)
+	defer cancel()
+
+	for _, eventType := range sub.EventTypes {
+		if !knownEvent(eventType) {
+			return nil, utils.InvalidField("event_types", "oneof", fmt.Sprintf("%s: %s", utils.WebhookEventUnknown, eventType))
+		}
+	}
+
+	if err := utils.CheckOutboundURL(ctx, sub.URL, wu.allowHTTP); err != nil {
+		return nil, err
+	}
+
+	sub.Active = true
+
+	res, err := wu.webhookRepo.Store(ctx, sub)
+
+	return res, err
+}
+
+func (wu *webhookUsecase) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, wu.contextTimeout)
+	defer cancel()
+
+	err = wu.webhookRepo.Delete(ctx, id)
+
+	return err
+}
+
+func (wu *webhookUsecase) FetchDeliveries(ctx context.Context, subscriptionID uuid.UUID, limit int64, offset int64) (*[]models.WebhookDelivery, error) {
+	ctx, cancel := context.WithTimeout(ctx, wu.contextTimeout)
+	defer cancel()
+
+	if limit == 0 {
+		limit = 20
+	}
+
+	if offset == 0 {
+		offset = 1
+	}
+
+	res, err := wu.webhookRepo.FetchDeliveries(ctx, subscriptionID, limit, offset)
+
+	return res, err
+}
+
+// Redeliver queues a fresh delivery of the same event, keeping the original
+// attempt in the delivery log.
+func (wu *webhookUsecase) Redeliver(ctx context.Context, deliveryID uuid.UUID) (*models.WebhookDelivery, error) {
+	ctx, cancel := context.WithTimeout(ctx, wu.contextTimeout)
+	defer cancel()
+
+	delivery, err := wu.webhookRepo.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := wu.webhookRepo.StoreDelivery(ctx, &models.WebhookDelivery{
+		SubscriptionID: delivery.SubscriptionID,
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Payload:        delivery.Payload,
+		Status:         models.WebhookDeliveryPending,
+		NextAttemptAt:  time.Now(),
+	})
+
+	return res, err
+}
+
+// HandleEvent queues a delivery of the event for every active subscription
+// to it. Subscriptions that already have a delivery for the event are skipped,
+// as the outbox may relay an event more than once.
+func (wu *webhookUsecase) HandleEvent(env events.Envelope) error {
+	ctx, cancel := context.WithTimeout(context.Background(), wu.contextTimeout)
+	defer cancel()
+
+	eventType := env.Event.Name()
+
+	subs, err := wu.webhookRepo.FindByEvent(ctx, eventType)
+	if err != nil {
+		return err
+	}
+
+	if len(*subs) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"id":         env.ID,
+		"event":      eventType,
+		"created_at": env.OccurredAt.UTC(),
+		"data":       env.Event,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, sub := range *subs {
+		exists, err := wu.webhookRepo.HasDelivery(ctx, sub.ID, env.ID)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+
+		if _, err := wu.webhookRepo.StoreDelivery(ctx, &models.WebhookDelivery{
+			SubscriptionID: sub.ID,
+			EventID:        env.ID,
+			EventType:      eventType,
+			Payload:        string(payload),
+			Status:         models.WebhookDeliveryPending,
+			NextAttemptAt:  time.Now(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Dispatch posts one batch of due deliveries. Failed deliveries are retried
+// with exponential backoff until maxAttempts is reached.
+func (wu *webhookUsecase) Dispatch() error {
+	ctx := context.Background()
+
+	due, err := wu.webhookRepo.ClaimDueDeliveries(ctx, dispatchBatchSize, dispatchLease)
+	if err != nil {
+		return err
+	}
+
+	for _, delivery := range *due {
+		status, err := wu.post(delivery)
+		attempts := delivery.Attempts + 1
+
+		fields := map[string]interface{}{
+			"attempts":        attempts,
+			"response_status": status,
+		}
+
+		if err == nil {
+			fields["status"] = models.WebhookDeliveryDelivered
+			fields["delivered_at"] = time.Now()
+			fields["last_error"] = ""
+		} else {
+			fields["last_error"] = err.Error()
+			fields["next_attempt_at"] = time.Now().Add(utils.Backoff(attempts))
+			if attempts >= maxAttempts {
+				fields["status"] = models.WebhookDeliveryFailed
+			}
+
+			logrus.WithFields(logrus.Fields{
+				"delivery": delivery.ID,
+				"event":    delivery.EventType,
+				"attempt":  attempts,
+			}).Warn(err)
+		}
+
+		if err := wu.webhookRepo.UpdateDelivery(ctx, delivery.ID, fields); err != nil {
+			logrus.Error(err)
+		}
+	}
+
+	return nil
+}
+
+// post delivers the event and returns the status the endpoint responded
+// with. The response body is drained but never kept, so the delivery log
+// cannot be used to read what an endpoint serves.
+func (wu *webhookUsecase) post(delivery models.WebhookDelivery) (status int, err error) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	// Subscriptions stored before the scheme was checked are held to it too
+	endpoint, err := utils.OutboundURL(delivery.Subscription.URL, wu.allowHTTP)
+	if err != nil {
+		return
+	}
+
+	req, err := http.NewRequest(http.MethodPost, endpoint.String(), bytes.NewBufferString(delivery.Payload))
+	if err != nil {
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Fatbellies-Webhook/1.0")
+	req.Header.Set("X-Fatbellies-Event", delivery.EventType)
+	req.Header.Set("X-Fatbellies-Delivery", delivery.ID.String())
+	req.Header.Set("X-Fatbellies-Timestamp", timestamp)
+	req.Header.Set("X-Fatbellies-Signature", "sha256="+sign(delivery.Subscription.Secret, timestamp, delivery.Payload))
+
+	res, err := wu.client.Do(req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+
+	// Draining lets the connection be reused
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(res.Body, maxResponseBody))
+	status = res.StatusCode
+
+	if status < 200 || status >= 300 {
+		err = errors.New("endpoint responded with " + res.Status)
+	}
+
+	return
+}
+
+// sign computes the HMAC-SHA256 of "<timestamp>.<payload>" with the
+// subscription secret, which partners recompute to verify a delivery.
+func sign(secret string, timestamp string, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "." + payload))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func knownEvent(eventType string) bool {
+	for _, e := range models.WebhookEvents {
+		if e == eventType {
+			return true
+		}
+	}
+
+	return false
+}
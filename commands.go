@@ -0,0 +1,231 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	br "github.com/iamaul/fatbellies/app/branch/repository"
+	"github.com/iamaul/fatbellies/app/bulk"
+	"github.com/iamaul/fatbellies/app/bulk/codec"
+	bku "github.com/iamaul/fatbellies/app/bulk/usecase"
+	mpr "github.com/iamaul/fatbellies/app/meal_plan/repository"
+	"github.com/iamaul/fatbellies/app/models"
+	uowr "github.com/iamaul/fatbellies/app/uow/repository"
+	"github.com/iamaul/fatbellies/config"
+	"github.com/iamaul/fatbellies/config/database"
+	"github.com/iamaul/fatbellies/config/migrations"
+)
+
+const usage = `usage:
+  engine                         start the API server
+  engine migrate up              apply all pending migrations
+  engine migrate down            revert the last applied migration
+  engine migrate status          list migrations and whether they are applied
+  engine migrate create <name>   write a new empty migration to config/migrations
+  engine import <branches|mealplans> <file> [--dry-run]
+                                 upsert the rows of a CSV, JSON or XLSX file by name
+  engine export <branches|mealplans> <file>
+                                 write every row to a CSV, JSON or XLSX file`
+
+// runCommand handles the subcommands of the binary, the API server being the
+// default when none is given.
+func runCommand(c *config.Configuration, args []string) error {
+	switch args[0] {
+	case "migrate":
+		return runMigrate(c, args[1:])
+	case "import":
+		return runImport(c, args[1:])
+	case "export":
+		return runExport(c, args[1:])
+	}
+
+	return fmt.Errorf("unknown command %q\n%s", args[0], usage)
+}
+
+func runMigrate(c *config.Configuration, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing migrate action\n%s", usage)
+	}
+
+	if args[0] == "create" {
+		if len(args) < 2 {
+			return fmt.Errorf("missing migration name\n%s", usage)
+		}
+
+		path, err := migrations.Create("config/migrations", args[1])
+		if err != nil {
+			return err
+		}
+
+		fmt.Println("created", path)
+
+		return nil
+	}
+
+	db, err := database.ConnectDatabase(c)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	database.LogMode(db, false)
+
+	switch args[0] {
+	case "up":
+		done, err := migrations.Up(db)
+		if err != nil {
+			return err
+		}
+		for _, m := range done {
+			fmt.Printf("applied  %s_%s\n", m.Version, m.Name)
+		}
+		if len(done) == 0 {
+			fmt.Println("nothing to migrate")
+		}
+	case "down":
+		done, err := migrations.Down(db)
+		if err != nil {
+			return err
+		}
+		if done == nil {
+			fmt.Println("nothing to revert")
+		} else {
+			fmt.Printf("reverted %s_%s\n", done.Version, done.Name)
+		}
+	case "status":
+		list, err := migrations.List(db)
+		if err != nil {
+			return err
+		}
+		for _, s := range list {
+			state := "pending"
+			if s.AppliedAt != nil {
+				state = "applied " + s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%s_%-40s %s\n", s.Version, s.Name, state)
+		}
+	default:
+		return fmt.Errorf("unknown migrate action %q\n%s", args[0], usage)
+	}
+
+	return nil
+}
+
+// newBulkUsecase connects to the database for the import and export
+// commands. The returned func closes the connection.
+func newBulkUsecase(c *config.Configuration) (bulk.Usecase, func(), error) {
+	db, err := database.ConnectDatabase(c)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	database.LogMode(db, false)
+
+	branchRepo := br.NewBranchRepository(db)
+	mealPlanRepo := mpr.NewMealPlanRepository(db)
+	unitOfWork := uowr.NewUnitOfWork(db)
+
+	return bku.NewBulkUsecase(branchRepo, mealPlanRepo, unitOfWork, time.Duration(c.BulkTimeout)*time.Second), func() { db.Close() }, nil
+}
+
+func runImport(c *config.Configuration, args []string) error {
+	if len(args) < 2 {
+		return fmt.Errorf("missing import entity or file\n%s", usage)
+	}
+
+	dryRun := len(args) > 2 && args[2] == "--dry-run"
+
+	format, err := codec.ParseFormat(args[1])
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(args[1])
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	bulkCase, closeDB, err := newBulkUsecase(c)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	var report *models.ImportReport
+
+	switch args[0] {
+	case "branches":
+		report, err = bulkCase.ImportBranches(context.Background(), format, file, dryRun, "cli")
+	case "mealplans":
+		report, err = bulkCase.ImportMealPlans(context.Background(), format, file, dryRun, "cli")
+	default:
+		return fmt.Errorf("unknown import entity %q\n%s", args[0], usage)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, row := range report.Rows {
+		fmt.Printf("row %-6d %-8s %s\n", row.Row, row.Action, row.Name)
+		for _, fe := range row.Errors {
+			fmt.Printf("           %s\n", fe.Message)
+		}
+	}
+
+	fmt.Printf("%d rows: %d created, %d updated, %d invalid\n", report.Total, report.Created, report.Updated, report.Invalid)
+
+	switch {
+	case report.Invalid > 0:
+		return fmt.Errorf("import has invalid rows, nothing was written")
+	case report.DryRun:
+		fmt.Println("dry run, nothing was written")
+	}
+
+	return nil
+}
+
+func runExport(c *config.Configuration, args []string) error {
+	if len(args) < 2 {
+		return fmt.Errorf("missing export entity or file\n%s", usage)
+	}
+
+	format, err := codec.ParseFormat(args[1])
+	if err != nil {
+		return err
+	}
+
+	bulkCase, closeDB, err := newBulkUsecase(c)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	// Checked before the file is created, so a typo does not truncate it
+	var export func(ctx context.Context, format codec.Format, w io.Writer) error
+	switch args[0] {
+	case "branches":
+		export = bulkCase.ExportBranches
+	case "mealplans":
+		export = bulkCase.ExportMealPlans
+	default:
+		return fmt.Errorf("unknown export entity %q\n%s", args[0], usage)
+	}
+
+	file, err := os.Create(args[1])
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if err := export(context.Background(), format, file); err != nil {
+		return err
+	}
+
+	fmt.Println("exported", args[1])
+
+	return file.Close()
+}
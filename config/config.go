@@ -16,9 +16,41 @@ type Configuration struct {
 	DbUsername    string `env:"DB_USERNAME,required"`
 	DbName        string `env:"DB_NAME,required"`
 	DbPassword    string `env:"DB_PASSWORD,required"`
+	AutoMigrate   bool   `env:"AUTO_MIGRATE" envDefault:"true"`
 	RedisHost     string `env:"REDIS_HOST,required"`
 	RedisPort     string `env:"REDIS_PORT" envDefault:"6379"`
 	RedisPassword string `env:"REDIS_PASSWORD,required"`
+
+	GRPCPort    string   `env:"GRPC_PORT" envDefault:":5001"`
+	GRPCAPIKeys []string `env:"GRPC_API_KEYS" envSeparator:","`
+
+	ContextTimeout int `env:"CONTEXT_TIMEOUT" envDefault:"10"`
+	BulkTimeout    int `env:"BULK_TIMEOUT" envDefault:"300"`
+
+	SMTPHost     string `env:"SMTP_HOST"`
+	SMTPPort     string `env:"SMTP_PORT" envDefault:"587"`
+	SMTPUsername string `env:"SMTP_USERNAME"`
+	SMTPPassword string `env:"SMTP_PASSWORD"`
+	SMTPFrom     string `env:"SMTP_FROM" envDefault:"no-reply@fatbellies.id"`
+
+	SMSAPIURL string `env:"SMS_API_URL"`
+	SMSAPIKey string `env:"SMS_API_KEY"`
+	SMSSender string `env:"SMS_SENDER" envDefault:"FATBELLIES"`
+
+	PushAPIURL    string `env:"PUSH_API_URL" envDefault:"https://fcm.googleapis.com/fcm/send"`
+	PushServerKey string `env:"PUSH_SERVER_KEY"`
+
+	NotificationLogFile  string `env:"NOTIFICATION_LOG_FILE"`
+	NotificationInterval int    `env:"NOTIFICATION_INTERVAL" envDefault:"10"`
+
+	WebhookInterval  int  `env:"WEBHOOK_INTERVAL" envDefault:"5"`
+	WebhookAllowHTTP bool `env:"WEBHOOK_ALLOW_HTTP" envDefault:"false"`
+
+	EventRelayInterval   int `env:"EVENT_RELAY_INTERVAL" envDefault:"1"`
+	OutboxRetentionHours int `env:"OUTBOX_RETENTION_HOURS" envDefault:"168"`
+
+	RetentionDays int `env:"SOFT_DELETE_RETENTION_DAYS" envDefault:"30"`
+	PurgeInterval int `env:"PURGE_INTERVAL" envDefault:"3600"`
 }
 
 func NewConfig(file ...string) *Configuration {
@@ -0,0 +1,85 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+// ctxDB runs gorm's statements with the *Context variants of database/sql, so
+// lib/pq cancels them server side once ctx is done.
+type ctxDB struct {
+	db  *sql.DB
+	ctx context.Context
+}
+
+func (c *ctxDB) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return c.db.ExecContext(c.ctx, query, args...)
+}
+
+func (c *ctxDB) Prepare(query string) (*sql.Stmt, error) {
+	return c.db.PrepareContext(c.ctx, query)
+}
+
+func (c *ctxDB) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return c.db.QueryContext(c.ctx, query, args...)
+}
+
+func (c *ctxDB) QueryRow(query string, args ...interface{}) *sql.Row {
+	return c.db.QueryRowContext(c.ctx, query, args...)
+}
+
+func (c *ctxDB) Begin() (*sql.Tx, error) {
+	return c.BeginTx(c.ctx, nil)
+}
+
+// BeginTx binds the transaction to c.ctx rather than the context gorm passes,
+// which is always Background. Statements on a *sql.Tx are not cancelled by
+// the transaction's context, so the remaining deadline is also handed to
+// Postgres as the transaction's statement_timeout.
+func (c *ctxDB) BeginTx(_ context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	tx, err := c.db.BeginTx(c.ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if deadline, ok := c.ctx.Deadline(); ok {
+		timeout := time.Until(deadline).Milliseconds()
+		if timeout < 1 {
+			timeout = 1
+		}
+
+		if _, err := tx.ExecContext(c.ctx, fmt.Sprintf("SET LOCAL statement_timeout = %d", timeout)); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	return tx, nil
+}
+
+// WithContext returns a handle on db whose statements are cancelled when ctx
+// is done. A db that is already a transaction is returned as it is, since it
+// was bound to a context when it began.
+func WithContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	var sqlDB *sql.DB
+
+	switch conn := db.CommonDB().(type) {
+	case *sql.DB:
+		sqlDB = conn
+	case *ctxDB:
+		sqlDB = conn.db
+	default:
+		return db
+	}
+
+	res, err := gorm.Open(db.Dialect().GetName(), &ctxDB{db: sqlDB, ctx: ctx})
+	if err != nil {
+		return db
+	}
+
+	return res.LogMode(logMode)
+}
@@ -11,7 +11,17 @@ import (
 	_ "github.com/jinzhu/gorm/dialects/postgres"
 )
 
-var dbConnection *gorm.DB
+var (
+	dbConnection *gorm.DB
+	logMode      = true
+)
+
+// LogMode turns SQL logging of db, and of the handles WithContext derives
+// from it, on or off.
+func LogMode(db *gorm.DB, enable bool) {
+	logMode = enable
+	db.LogMode(enable)
+}
 
 func ConnectDatabase(c *config.Configuration) (*gorm.DB, error) {
 	dbargs := fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=disable", c.DbHost, c.DbPort, c.DbUsername, c.DbName, c.DbPassword)
@@ -23,7 +33,7 @@ func ConnectDatabase(c *config.Configuration) (*gorm.DB, error) {
 	connect.DB().SetMaxIdleConns(20)
 	connect.DB().SetMaxOpenConns(200)
 
-	connect.LogMode(true)
+	connect.LogMode(logMode)
 
 	dbConnection = connect
 
@@ -0,0 +1,189 @@
+package migrations
+
+func init() {
+	register(Migration{
+		Version: "20261019100000",
+		Name:    "initial_schema",
+		Up: `
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+CREATE TABLE IF NOT EXISTS branches (
+	id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+	branch_name varchar(125) NULL,
+	opening_hours integer DEFAULT 0,
+	created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP,
+	updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP,
+	deleted_at timestamp without time zone
+);
+-- CREATE TABLE IF NOT EXISTS keeps a table AutoMigrate created before some
+-- of its columns existed, so the columns the indexes and constraints below
+-- rely on are added to it. deleted_at was a plain time before soft delete,
+-- zero times would read as deleted.
+ALTER TABLE branches
+	ADD COLUMN IF NOT EXISTS branch_name varchar(125) NULL,
+	ADD COLUMN IF NOT EXISTS opening_hours integer DEFAULT 0,
+	ADD COLUMN IF NOT EXISTS created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP,
+	ADD COLUMN IF NOT EXISTS updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP,
+	ADD COLUMN IF NOT EXISTS deleted_at timestamp without time zone;
+UPDATE branches SET deleted_at = NULL WHERE deleted_at = '0001-01-01 00:00:00';
+CREATE INDEX IF NOT EXISTS idx_branches_deleted_at ON branches (deleted_at);
+
+CREATE TABLE IF NOT EXISTS branch_locations (
+	id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+	branch_id uuid,
+	latitude decimal(10,8) DEFAULT 0,
+	longitude decimal(11,8) DEFAULT 0,
+	distance numeric,
+	deleted_at timestamp without time zone
+);
+ALTER TABLE branch_locations
+	ADD COLUMN IF NOT EXISTS branch_id uuid,
+	ADD COLUMN IF NOT EXISTS latitude decimal(10,8) DEFAULT 0,
+	ADD COLUMN IF NOT EXISTS longitude decimal(11,8) DEFAULT 0,
+	ADD COLUMN IF NOT EXISTS distance numeric,
+	ADD COLUMN IF NOT EXISTS deleted_at timestamp without time zone;
+CREATE INDEX IF NOT EXISTS idx_branch_locations_branch_id ON branch_locations (branch_id);
+CREATE INDEX IF NOT EXISTS idx_branch_locations_deleted_at ON branch_locations (deleted_at);
+
+CREATE TABLE IF NOT EXISTS meal_plans (
+	id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+	meal_plan_name varchar(150) NULL,
+	max_capacity integer DEFAULT 10,
+	price integer DEFAULT 5,
+	day varchar(40) NULL,
+	start_time timestamp without time zone NULL,
+	end_time timestamp without time zone NULL,
+	created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP,
+	updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP,
+	deleted_at timestamp without time zone
+);
+ALTER TABLE meal_plans
+	ADD COLUMN IF NOT EXISTS meal_plan_name varchar(150) NULL,
+	ADD COLUMN IF NOT EXISTS max_capacity integer DEFAULT 10,
+	ADD COLUMN IF NOT EXISTS price integer DEFAULT 5,
+	ADD COLUMN IF NOT EXISTS day varchar(40) NULL,
+	ADD COLUMN IF NOT EXISTS start_time timestamp without time zone NULL,
+	ADD COLUMN IF NOT EXISTS end_time timestamp without time zone NULL,
+	ADD COLUMN IF NOT EXISTS created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP,
+	ADD COLUMN IF NOT EXISTS updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP,
+	ADD COLUMN IF NOT EXISTS deleted_at timestamp without time zone;
+UPDATE meal_plans SET deleted_at = NULL WHERE deleted_at = '0001-01-01 00:00:00';
+CREATE INDEX IF NOT EXISTS idx_meal_plans_deleted_at ON meal_plans (deleted_at);
+
+CREATE TABLE IF NOT EXISTS branch_meal_plans (
+	branch_id uuid NOT NULL,
+	meal_plan_id uuid NOT NULL,
+	deleted_at timestamp without time zone,
+	PRIMARY KEY (branch_id, meal_plan_id)
+);
+ALTER TABLE branch_meal_plans
+	ADD COLUMN IF NOT EXISTS deleted_at timestamp without time zone;
+CREATE INDEX IF NOT EXISTS idx_branch_meal_plans_meal_plan_id ON branch_meal_plans (meal_plan_id);
+CREATE INDEX IF NOT EXISTS idx_branch_meal_plans_deleted_at ON branch_meal_plans (deleted_at);
+
+CREATE TABLE IF NOT EXISTS notifications (
+	id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+	event varchar(60) NOT NULL,
+	channel varchar(20) NOT NULL,
+	locale varchar(10) NOT NULL,
+	recipient varchar(255) NOT NULL,
+	subject varchar(255),
+	body text,
+	status varchar(20) DEFAULT 'pending',
+	attempts integer DEFAULT 0,
+	next_attempt_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP,
+	last_error text,
+	sent_at timestamp without time zone NULL,
+	created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP,
+	updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_notifications_status ON notifications (status);
+CREATE INDEX IF NOT EXISTS idx_notifications_next_attempt_at ON notifications (next_attempt_at);
+
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+	id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+	url varchar(255) NOT NULL,
+	secret varchar(255) NOT NULL,
+	event_types text[],
+	active boolean DEFAULT true,
+	created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP,
+	updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+	id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+	subscription_id uuid NOT NULL,
+	event_id uuid NOT NULL,
+	event_type varchar(60) NOT NULL,
+	payload jsonb NOT NULL,
+	status varchar(20) DEFAULT 'pending',
+	attempts integer DEFAULT 0,
+	next_attempt_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP,
+	response_status integer DEFAULT 0,
+	response_body text,
+	last_error text,
+	delivered_at timestamp without time zone NULL,
+	created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP,
+	updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_subscription_id ON webhook_deliveries (subscription_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_status ON webhook_deliveries (status);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_next_attempt_at ON webhook_deliveries (next_attempt_at);
+
+CREATE TABLE IF NOT EXISTS outbox_events (
+	id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+	name varchar(60) NOT NULL,
+	payload jsonb NOT NULL,
+	actor varchar(125) NOT NULL DEFAULT '',
+	occurred_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP,
+	attempts integer DEFAULT 0,
+	next_attempt_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP,
+	last_error text,
+	published_at timestamp without time zone NULL
+);
+ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS actor varchar(125) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_outbox_events_next_attempt_at ON outbox_events (next_attempt_at);
+CREATE INDEX IF NOT EXISTS idx_outbox_events_published_at ON outbox_events (published_at);
+
+CREATE TABLE IF NOT EXISTS audit_entries (
+	id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+	event_id uuid NOT NULL,
+	actor varchar(125) NOT NULL,
+	action varchar(20) NOT NULL,
+	entity varchar(40) NOT NULL,
+	entity_id varchar(80) NOT NULL,
+	changes jsonb NOT NULL,
+	created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_audit_entries_event_entity ON audit_entries (event_id, entity, entity_id);
+CREATE INDEX IF NOT EXISTS idx_audit_entries_entity ON audit_entries (entity, entity_id);
+CREATE INDEX IF NOT EXISTS idx_audit_entries_actor ON audit_entries (actor);
+CREATE INDEX IF NOT EXISTS idx_audit_entries_created_at ON audit_entries (created_at);
+
+-- Rows orphaned by hard deletes before soft delete existed
+DELETE FROM branch_locations WHERE branch_id IS NULL OR branch_id NOT IN (SELECT id FROM branches);
+DELETE FROM branch_meal_plans WHERE branch_id NOT IN (SELECT id FROM branches) OR meal_plan_id NOT IN (SELECT id FROM meal_plans);
+DELETE FROM webhook_deliveries WHERE subscription_id NOT IN (SELECT id FROM webhook_subscriptions);
+
+ALTER TABLE branch_locations ADD CONSTRAINT branch_locations_branch_id_fkey
+	FOREIGN KEY (branch_id) REFERENCES branches (id);
+ALTER TABLE branch_meal_plans ADD CONSTRAINT branch_meal_plans_branch_id_fkey
+	FOREIGN KEY (branch_id) REFERENCES branches (id);
+ALTER TABLE branch_meal_plans ADD CONSTRAINT branch_meal_plans_meal_plan_id_fkey
+	FOREIGN KEY (meal_plan_id) REFERENCES meal_plans (id);
+ALTER TABLE webhook_deliveries ADD CONSTRAINT webhook_deliveries_subscription_id_fkey
+	FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions (id);
+`,
+		Down: `
+DROP TABLE IF EXISTS audit_entries;
+DROP TABLE IF EXISTS outbox_events;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_subscriptions;
+DROP TABLE IF EXISTS notifications;
+DROP TABLE IF EXISTS branch_meal_plans;
+DROP TABLE IF EXISTS meal_plans;
+DROP TABLE IF EXISTS branch_locations;
+DROP TABLE IF EXISTS branches;
+`,
+	})
+}
@@ -0,0 +1,51 @@
+package migrations
+
+func init() {
+	register(Migration{
+		Version: "20261019110000",
+		Name:    "integrity_constraints",
+		Up: `
+-- Keep the oldest branch of every duplicated name, suffix the others
+UPDATE branches b SET branch_name = left(b.branch_name, 115) || ' #' || left(b.id::text, 8)
+FROM branches o
+WHERE b.branch_name = o.branch_name
+	AND b.deleted_at IS NULL AND o.deleted_at IS NULL
+	AND (o.created_at, o.id) < (b.created_at, b.id);
+CREATE UNIQUE INDEX branches_branch_name_key ON branches (branch_name) WHERE deleted_at IS NULL;
+
+DELETE FROM branch_meal_plans a USING branch_meal_plans b
+WHERE a.ctid < b.ctid AND a.branch_id = b.branch_id AND a.meal_plan_id = b.meal_plan_id;
+ALTER TABLE branch_meal_plans DROP CONSTRAINT IF EXISTS branch_meal_plans_pkey;
+ALTER TABLE branch_meal_plans ADD CONSTRAINT branch_meal_plans_pkey PRIMARY KEY (branch_id, meal_plan_id);
+
+ALTER TABLE branch_locations DROP CONSTRAINT branch_locations_branch_id_fkey;
+ALTER TABLE branch_locations ADD CONSTRAINT branch_locations_branch_id_fkey
+	FOREIGN KEY (branch_id) REFERENCES branches (id) ON DELETE CASCADE;
+ALTER TABLE branch_meal_plans DROP CONSTRAINT branch_meal_plans_branch_id_fkey;
+ALTER TABLE branch_meal_plans ADD CONSTRAINT branch_meal_plans_branch_id_fkey
+	FOREIGN KEY (branch_id) REFERENCES branches (id) ON DELETE CASCADE;
+ALTER TABLE branch_meal_plans DROP CONSTRAINT branch_meal_plans_meal_plan_id_fkey;
+ALTER TABLE branch_meal_plans ADD CONSTRAINT branch_meal_plans_meal_plan_id_fkey
+	FOREIGN KEY (meal_plan_id) REFERENCES meal_plans (id) ON DELETE CASCADE;
+ALTER TABLE webhook_deliveries DROP CONSTRAINT webhook_deliveries_subscription_id_fkey;
+ALTER TABLE webhook_deliveries ADD CONSTRAINT webhook_deliveries_subscription_id_fkey
+	FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions (id) ON DELETE CASCADE;
+`,
+		Down: `
+ALTER TABLE webhook_deliveries DROP CONSTRAINT webhook_deliveries_subscription_id_fkey;
+ALTER TABLE webhook_deliveries ADD CONSTRAINT webhook_deliveries_subscription_id_fkey
+	FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions (id);
+ALTER TABLE branch_meal_plans DROP CONSTRAINT branch_meal_plans_meal_plan_id_fkey;
+ALTER TABLE branch_meal_plans ADD CONSTRAINT branch_meal_plans_meal_plan_id_fkey
+	FOREIGN KEY (meal_plan_id) REFERENCES meal_plans (id);
+ALTER TABLE branch_meal_plans DROP CONSTRAINT branch_meal_plans_branch_id_fkey;
+ALTER TABLE branch_meal_plans ADD CONSTRAINT branch_meal_plans_branch_id_fkey
+	FOREIGN KEY (branch_id) REFERENCES branches (id);
+ALTER TABLE branch_locations DROP CONSTRAINT branch_locations_branch_id_fkey;
+ALTER TABLE branch_locations ADD CONSTRAINT branch_locations_branch_id_fkey
+	FOREIGN KEY (branch_id) REFERENCES branches (id);
+
+DROP INDEX IF EXISTS branches_branch_name_key;
+`,
+	})
+}
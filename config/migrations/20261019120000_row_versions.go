@@ -0,0 +1,16 @@
+package migrations
+
+func init() {
+	register(Migration{
+		Version: "20261019120000",
+		Name:    "row_versions",
+		Up: `
+ALTER TABLE branches ADD COLUMN version bigint NOT NULL DEFAULT 1;
+ALTER TABLE meal_plans ADD COLUMN version bigint NOT NULL DEFAULT 1;
+`,
+		Down: `
+ALTER TABLE meal_plans DROP COLUMN version;
+ALTER TABLE branches DROP COLUMN version;
+`,
+	})
+}
@@ -0,0 +1,32 @@
+package migrations
+
+func init() {
+	register(Migration{
+		Version: "20261019130000",
+		Name:    "search",
+		Up: `
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE branches ADD COLUMN search_vector tsvector
+	GENERATED ALWAYS AS (setweight(to_tsvector('simple', coalesce(branch_name, '')), 'A')) STORED;
+ALTER TABLE meal_plans ADD COLUMN search_vector tsvector
+	GENERATED ALWAYS AS (
+		setweight(to_tsvector('simple', coalesce(meal_plan_name, '')), 'A') ||
+		setweight(to_tsvector('simple', coalesce(day, '')), 'B')
+	) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_branches_search_vector ON branches USING gin (search_vector);
+CREATE INDEX IF NOT EXISTS idx_branches_branch_name_trgm ON branches USING gin (branch_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_meal_plans_search_vector ON meal_plans USING gin (search_vector);
+CREATE INDEX IF NOT EXISTS idx_meal_plans_meal_plan_name_trgm ON meal_plans USING gin (meal_plan_name gin_trgm_ops);
+`,
+		Down: `
+DROP INDEX IF EXISTS idx_meal_plans_meal_plan_name_trgm;
+DROP INDEX IF EXISTS idx_meal_plans_search_vector;
+DROP INDEX IF EXISTS idx_branches_branch_name_trgm;
+DROP INDEX IF EXISTS idx_branches_search_vector;
+ALTER TABLE meal_plans DROP COLUMN search_vector;
+ALTER TABLE branches DROP COLUMN search_vector;
+`,
+	})
+}
@@ -0,0 +1,35 @@
+package migrations
+
+func init() {
+	register(Migration{
+		Version: "20261019140000",
+		Name:    "translations",
+		Up: `
+CREATE TABLE IF NOT EXISTS branch_translations (
+	branch_id uuid NOT NULL,
+	locale varchar(35) NOT NULL,
+	branch_name varchar(125) NOT NULL,
+	created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP,
+	updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP,
+	CONSTRAINT branch_translations_pkey PRIMARY KEY (branch_id, locale),
+	CONSTRAINT branch_translations_branch_id_fkey
+		FOREIGN KEY (branch_id) REFERENCES branches (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS meal_plan_translations (
+	meal_plan_id uuid NOT NULL,
+	locale varchar(35) NOT NULL,
+	meal_plan_name varchar(150) NOT NULL,
+	created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP,
+	updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP,
+	CONSTRAINT meal_plan_translations_pkey PRIMARY KEY (meal_plan_id, locale),
+	CONSTRAINT meal_plan_translations_meal_plan_id_fkey
+		FOREIGN KEY (meal_plan_id) REFERENCES meal_plans (id) ON DELETE CASCADE
+);
+`,
+		Down: `
+DROP TABLE IF EXISTS meal_plan_translations;
+DROP TABLE IF EXISTS branch_translations;
+`,
+	})
+}
@@ -0,0 +1,25 @@
+package migrations
+
+func init() {
+	register(Migration{
+		Version: "20261019150000",
+		Name:    "session_timezones",
+		Up: `
+ALTER TABLE branches ADD COLUMN IF NOT EXISTS timezone varchar(64) NOT NULL DEFAULT 'Asia/Jakarta';
+ALTER TABLE meal_plans ADD COLUMN IF NOT EXISTS timezone varchar(64) NOT NULL DEFAULT 'Asia/Jakarta';
+
+-- Sessions were written as Asia/Jakarta wall clock times
+ALTER TABLE meal_plans
+	ALTER COLUMN start_time TYPE timestamp with time zone USING start_time AT TIME ZONE 'Asia/Jakarta',
+	ALTER COLUMN end_time TYPE timestamp with time zone USING end_time AT TIME ZONE 'Asia/Jakarta';
+`,
+		Down: `
+ALTER TABLE meal_plans
+	ALTER COLUMN start_time TYPE timestamp without time zone USING start_time AT TIME ZONE timezone,
+	ALTER COLUMN end_time TYPE timestamp without time zone USING end_time AT TIME ZONE timezone;
+
+ALTER TABLE meal_plans DROP COLUMN IF EXISTS timezone;
+ALTER TABLE branches DROP COLUMN IF EXISTS timezone;
+`,
+	})
+}
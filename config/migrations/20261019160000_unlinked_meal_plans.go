@@ -0,0 +1,22 @@
+package migrations
+
+func init() {
+	register(Migration{
+		Version: "20261019160000",
+		Name:    "unlinked_meal_plans",
+		Up: `
+-- Unlinking used to soft delete the link, which the many2many preloads do
+-- not filter on. Links soft deleted along with their branch or meal plan
+-- carry its deleted_at and are kept for Restore.
+DELETE FROM branch_meal_plans l
+USING branches b, meal_plans m
+WHERE l.branch_id = b.id AND l.meal_plan_id = m.id
+	AND l.deleted_at IS NOT NULL
+	AND l.deleted_at IS DISTINCT FROM b.deleted_at
+	AND l.deleted_at IS DISTINCT FROM m.deleted_at;
+`,
+		Down: `
+-- The deleted links were already unlinked and are not brought back
+`,
+	})
+}
@@ -0,0 +1,16 @@
+package migrations
+
+func init() {
+	register(Migration{
+		Version: "20261019170000",
+		Name:    "webhook_response_body",
+		Up: `
+-- Response bodies let subscribers read what any address the server can
+-- reach serves. Only the status is kept.
+ALTER TABLE webhook_deliveries DROP COLUMN IF EXISTS response_body;
+`,
+		Down: `
+ALTER TABLE webhook_deliveries ADD COLUMN IF NOT EXISTS response_body text;
+`,
+	})
+}
@@ -0,0 +1,40 @@
+package migrations
+
+func init() {
+	register(Migration{
+		Version: "20261019180000",
+		Name:    "weekly_sessions",
+		Up: `
+-- Sessions were stored as the instants of the first one, which went stale
+-- once it passed. Keep the weekday and the local times of day instead, the
+-- instants being resolved when read. Days that are not a weekday become
+-- the one the stored session fell on.
+UPDATE meal_plans SET day = CASE
+	WHEN lower(trim(day)) IN ('sunday', 'monday', 'tuesday', 'wednesday', 'thursday', 'friday', 'saturday') THEN initcap(trim(day))
+	WHEN start_time IS NOT NULL THEN to_char(start_time AT TIME ZONE timezone, 'FMDay')
+	ELSE day
+END;
+
+-- Times of day are kept as HH24:MI:SS text, which sorts like the times do
+-- and reads back as the string clients sent.
+ALTER TABLE meal_plans
+	ALTER COLUMN start_time TYPE varchar(8) USING to_char(start_time AT TIME ZONE timezone, 'HH24:MI:SS'),
+	ALTER COLUMN end_time TYPE varchar(8) USING to_char(end_time AT TIME ZONE timezone, 'HH24:MI:SS');
+`,
+		Down: `
+-- Sessions go back to the instants of the next one
+ALTER TABLE meal_plans
+	ALTER COLUMN start_time TYPE timestamp with time zone USING (
+		current_date
+		+ ((array_position(ARRAY['Sunday', 'Monday', 'Tuesday', 'Wednesday', 'Thursday', 'Friday', 'Saturday'], day) - 1 - extract(dow FROM current_date)::int + 7) % 7)
+		+ start_time::time
+	) AT TIME ZONE timezone,
+	ALTER COLUMN end_time TYPE timestamp with time zone USING (
+		current_date
+		+ ((array_position(ARRAY['Sunday', 'Monday', 'Tuesday', 'Wednesday', 'Thursday', 'Friday', 'Saturday'], day) - 1 - extract(dow FROM current_date)::int + 7) % 7)
+		+ CASE WHEN end_time::time <= start_time::time THEN 1 ELSE 0 END
+		+ end_time::time
+	) AT TIME ZONE timezone;
+`,
+	})
+}
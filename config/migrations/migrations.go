@@ -1,14 +1,216 @@
 package migrations
 
 import (
-	"github.com/iamaul/fatbellies/app/models"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
 	"github.com/jinzhu/gorm"
 	_ "github.com/jinzhu/gorm/dialects/postgres"
 )
 
-func Migrate(db *gorm.DB) {
-	Branch := &models.Branch{}
-	BranchLocation := &models.BranchLocation{}
-	MealPlan := &models.MealPlan{}
-	db.AutoMigrate(&Branch, &BranchLocation, &MealPlan)
+// lockKey is the advisory lock held while migrating, so replicas starting at
+// the same time do not apply the same migration twice.
+const lockKey = 7301846529
+
+// Migration is one versioned schema change. Up and Down are plain SQL and
+// may hold several statements.
+type Migration struct {
+	Version string
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status reports whether a migration has been applied
+type Status struct {
+	Version   string
+	Name      string
+	AppliedAt *time.Time
+}
+
+type schemaMigration struct {
+	Version   string    `gorm:"primary_key; type:varchar(14)"`
+	Name      string    `gorm:"type:varchar(150); not null"`
+	AppliedAt time.Time `gorm:"type:timestamp without time zone; default:CURRENT_TIMESTAMP"`
+}
+
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+var registry []Migration
+
+// register is called from the init function of every migration file
+func register(m Migration) {
+	registry = append(registry, m)
+}
+
+func sorted() []Migration {
+	ms := append([]Migration{}, registry...)
+	sort.Slice(ms, func(i, j int) bool { return ms[i].Version < ms[j].Version })
+
+	return ms
+}
+
+func prepare(tx *gorm.DB) error {
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", lockKey).Error; err != nil {
+		return err
+	}
+
+	return tx.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version varchar(14) PRIMARY KEY,
+		name varchar(150) NOT NULL,
+		applied_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP
+	)`).Error
+}
+
+func applied(tx *gorm.DB) (map[string]schemaMigration, error) {
+	var rows []schemaMigration
+	if err := tx.Order("version").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]schemaMigration, len(rows))
+	for _, row := range rows {
+		res[row.Version] = row
+	}
+
+	return res, nil
+}
+
+// Up applies every pending migration in version order, in one transaction
+func Up(db *gorm.DB) (done []Migration, err error) {
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if err := prepare(tx); err != nil {
+			return err
+		}
+
+		versions, err := applied(tx)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range sorted() {
+			if _, ok := versions[m.Version]; ok {
+				continue
+			}
+
+			if err := tx.Exec(m.Up).Error; err != nil {
+				return fmt.Errorf("migration %s_%s: %v", m.Version, m.Name, err)
+			}
+
+			if err := tx.Create(&schemaMigration{Version: m.Version, Name: m.Name, AppliedAt: time.Now()}).Error; err != nil {
+				return err
+			}
+
+			done = append(done, m)
+		}
+
+		return nil
+	})
+	if err != nil {
+		done = nil
+	}
+
+	return
+}
+
+// Down reverts the most recently applied migration
+func Down(db *gorm.DB) (done *Migration, err error) {
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if err := prepare(tx); err != nil {
+			return err
+		}
+
+		last := schemaMigration{}
+		if err := tx.Order("version desc").First(&last).Error; err != nil {
+			if gorm.IsRecordNotFoundError(err) {
+				return nil
+			}
+			return err
+		}
+
+		for _, m := range registry {
+			if m.Version != last.Version {
+				continue
+			}
+
+			if err := tx.Exec(m.Down).Error; err != nil {
+				return fmt.Errorf("migration %s_%s: %v", m.Version, m.Name, err)
+			}
+
+			if err := tx.Delete(&schemaMigration{Version: m.Version}).Error; err != nil {
+				return err
+			}
+
+			done = &m
+
+			return nil
+		}
+
+		return fmt.Errorf("applied migration %s_%s is not known to this binary", last.Version, last.Name)
+	})
+
+	return
+}
+
+// List returns every known migration with the time it was applied, if any
+func List(db *gorm.DB) (res []Status, err error) {
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if err := prepare(tx); err != nil {
+			return err
+		}
+
+		versions, err := applied(tx)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range sorted() {
+			s := Status{Version: m.Version, Name: m.Name}
+			if row, ok := versions[m.Version]; ok {
+				appliedAt := row.AppliedAt
+				s.AppliedAt = &appliedAt
+			}
+			res = append(res, s)
+		}
+
+		return nil
+	})
+
+	return
+}
+
+var nameSanitizer = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Create writes an empty migration file into dir and returns its path
+func Create(dir string, name string) (string, error) {
+	name = strings.Trim(nameSanitizer.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" {
+		return "", fmt.Errorf("migration name is required")
+	}
+
+	version := time.Now().UTC().Format("20060102150405")
+	path := filepath.Join(dir, version+"_"+name+".go")
+
+	content := fmt.Sprintf(`package migrations
+
+func init() {
+	register(Migration{
+		Version: %q,
+		Name:    %q,
+		Up: `+"`"+`
+`+"`"+`,
+		Down: `+"`"+`
+`+"`"+`,
+	})
+}
+`, version, name)
+
+	return path, ioutil.WriteFile(path, []byte(content), 0644)
 }
@@ -1,854 +0,0 @@
-// GENERATED BY THE COMMAND ABOVE; DO NOT EDIT
-// This file was generated by swaggo/swag
-
-package docs
-
-import (
-	"bytes"
-	"encoding/json"
-	"strings"
-
-	"github.com/alecthomas/template"
-	"github.com/swaggo/swag"
-)
-
-var doc = `{
-    "schemes": {{ marshal .Schemes }},
-    "swagger": "2.0",
-    "info": {
-        "description": "{{.Description}}",
-        "title": "{{.Title}}",
-        "contact": {
-            "name": "iamaul",
-            "url": "https://iamaul.me",
-            "email": "iamaul@hotmail.com"
-        },
-        "license": {
-            "name": "Apache 2.0",
-            "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
-        },
-        "version": "{{.Version}}"
-    },
-    "host": "{{.Host}}",
-    "basePath": "{{.BasePath}}",
-    "paths": {
-        "/api/branches": {
-            "get": {
-                "description": "Get a list of branches",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Branches"
-                ],
-                "summary": "List branches",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "limit numbers",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "pagination",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "created_at desc",
-                        "name": "order",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Branch"
-                            }
-                        }
-                    }
-                }
-            },
-            "post": {
-                "description": "Add new branch",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Branches"
-                ],
-                "summary": "Add branch",
-                "parameters": [
-                    {
-                        "description": "Form JSON",
-                        "name": "branch",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.SwagBranch"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Branch"
-                            }
-                        }
-                    }
-                }
-            }
-        },
-        "/api/branches/branch/{name}": {
-            "get": {
-                "description": "Get branch by Name",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Branches"
-                ],
-                "summary": "Find one of all the branches",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Branch name",
-                        "name": "name",
-                        "in": "path"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Branch"
-                            }
-                        }
-                    }
-                }
-            }
-        },
-        "/api/branches/mealplans": {
-            "post": {
-                "description": "Add new branch meal plan",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Branches"
-                ],
-                "summary": "Add branch meal plan",
-                "parameters": [
-                    {
-                        "description": "Form JSON",
-                        "name": "branch_meal_plan",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.BranchMealPlan"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.BranchMealPlan"
-                            }
-                        }
-                    }
-                }
-            }
-        },
-        "/api/branches/{id}": {
-            "get": {
-                "description": "Get branch by ID",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Branches"
-                ],
-                "summary": "Find one of all the branches",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Branch ID",
-                        "name": "id",
-                        "in": "path"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Branch"
-                            }
-                        }
-                    }
-                }
-            }
-        },
-        "/api/delete/branches/{id}": {
-            "delete": {
-                "description": "Delete branch by ID",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Branches"
-                ],
-                "summary": "Delete one of all the branches",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Branch ID",
-                        "name": "id",
-                        "in": "path"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": ""
-                    }
-                }
-            }
-        },
-        "/api/delete/mealplans/{id}": {
-            "delete": {
-                "description": "Delete meal plan by ID",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Meal Plans"
-                ],
-                "summary": "Delete one of all the meal plans",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Meal plan ID",
-                        "name": "id",
-                        "in": "path"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": ""
-                    }
-                }
-            }
-        },
-        "/api/mealplans": {
-            "get": {
-                "description": "Get a list of meal plans",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Meal Plans"
-                ],
-                "summary": "List meal plan",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "limit numbers",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "pagination",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "created_at desc",
-                        "name": "order",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.MealPlan"
-                            }
-                        }
-                    }
-                }
-            },
-            "post": {
-                "description": "Add new meal plan",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Meal Plans"
-                ],
-                "summary": "Add meal plan",
-                "parameters": [
-                    {
-                        "description": "Form JSON",
-                        "name": "meal_plan",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.SwagMealPlan"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.MealPlan"
-                            }
-                        }
-                    }
-                }
-            }
-        },
-        "/api/mealplans/meal/{name}": {
-            "get": {
-                "description": "Get meal plan by Name",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Meal Plans"
-                ],
-                "summary": "Find one of all the meal plans",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Meal plan name",
-                        "name": "name",
-                        "in": "path"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.MealPlan"
-                            }
-                        }
-                    }
-                }
-            }
-        },
-        "/api/mealplans/{id}": {
-            "get": {
-                "description": "Get meal plan by ID",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Meal Plans"
-                ],
-                "summary": "Find one of all the meal plans",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Meal Plan ID",
-                        "name": "id",
-                        "in": "path"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.MealPlan"
-                            }
-                        }
-                    }
-                }
-            }
-        },
-        "/api/nearest/branches": {
-            "get": {
-                "description": "Get nearest location between branch and user",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Branches"
-                ],
-                "summary": "Find nearest location",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Latitude",
-                        "name": "lat",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Longitude",
-                        "name": "long",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.BranchLocation"
-                            }
-                        }
-                    }
-                }
-            }
-        },
-        "/api/search/branches": {
-            "post": {
-                "description": "Search branch by specific queries column, q, and ID",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Branches"
-                ],
-                "summary": "Search branches",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "branch_name",
-                        "name": "column",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "restaurant",
-                        "name": "q",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "created_at desc",
-                        "name": "order",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Branch"
-                            }
-                        }
-                    }
-                }
-            }
-        },
-        "/api/search/mealplans": {
-            "post": {
-                "description": "Search meal plan by specific queries column, q, and ID",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Meal Plans"
-                ],
-                "summary": "Search meal plans",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "meal_plan_name",
-                        "name": "column",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Buffet A",
-                        "name": "q",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "created_at desc",
-                        "name": "order",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.MealPlan"
-                            }
-                        }
-                    }
-                }
-            }
-        },
-        "/api/update/branches/{id}": {
-            "put": {
-                "description": "Update branch by ID",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Branches"
-                ],
-                "summary": "Update branch",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Branch ID",
-                        "name": "id",
-                        "in": "path"
-                    },
-                    {
-                        "description": "Form JSON",
-                        "name": "branch",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.SwagBranch"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Branch"
-                            }
-                        }
-                    }
-                }
-            }
-        },
-        "/api/update/mealplans/{id}": {
-            "put": {
-                "description": "Update meal plan by ID",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Meal Plans"
-                ],
-                "summary": "Update meal plan",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Meal plan ID",
-                        "name": "id",
-                        "in": "path"
-                    },
-                    {
-                        "description": "Form JSON",
-                        "name": "meal_plan",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.SwagMealPlan"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.SwagMealPlan"
-                            }
-                        }
-                    }
-                }
-            }
-        }
-    },
-    "definitions": {
-        "models.Branch": {
-            "type": "object",
-            "required": [
-                "branch_name",
-                "opening_hours"
-            ],
-            "properties": {
-                "branch_meal_plans": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.MealPlan"
-                    }
-                },
-                "branch_name": {
-                    "type": "string"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "deleted_at": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "locations": {
-                    "$ref": "#/definitions/models.BranchLocation"
-                },
-                "opening_hours": {
-                    "type": "integer"
-                },
-                "updated_at": {
-                    "type": "string"
-                }
-            }
-        },
-        "models.BranchLocation": {
-            "type": "object",
-            "properties": {
-                "branch_id": {
-                    "type": "string"
-                },
-                "distance": {
-                    "type": "number"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "latitude": {
-                    "type": "number"
-                },
-                "longitude": {
-                    "type": "number"
-                }
-            }
-        },
-        "models.BranchMealPlan": {
-            "type": "object",
-            "required": [
-                "branch_id",
-                "meal_plan_id"
-            ],
-            "properties": {
-                "branch_id": {
-                    "type": "string"
-                },
-                "meal_plan_id": {
-                    "type": "string"
-                }
-            }
-        },
-        "models.MealPlan": {
-            "type": "object",
-            "required": [
-                "day",
-                "max_capacity",
-                "meal_plan_name",
-                "price"
-            ],
-            "properties": {
-                "branch_meal_plans": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.Branch"
-                    }
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "day": {
-                    "type": "string"
-                },
-                "deleted_at": {
-                    "type": "string"
-                },
-                "end_time": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "max_capacity": {
-                    "type": "integer"
-                },
-                "meal_plan_name": {
-                    "type": "string"
-                },
-                "price": {
-                    "type": "integer"
-                },
-                "start_time": {
-                    "type": "string"
-                },
-                "updated_at": {
-                    "type": "string"
-                }
-            }
-        },
-        "models.SwagBranch": {
-            "type": "object",
-            "properties": {
-                "branch_name": {
-                    "type": "string"
-                },
-                "locations": {
-                    "$ref": "#/definitions/models.SwagBranchLocation"
-                },
-                "opening_hours": {
-                    "type": "integer"
-                }
-            }
-        },
-        "models.SwagBranchLocation": {
-            "type": "object",
-            "properties": {
-                "latitude": {
-                    "type": "number"
-                },
-                "longitude": {
-                    "type": "number"
-                }
-            }
-        },
-        "models.SwagMealPlan": {
-            "type": "object",
-            "properties": {
-                "day": {
-                    "type": "string"
-                },
-                "max_capacity": {
-                    "type": "integer"
-                },
-                "meal_plan_name": {
-                    "type": "string"
-                },
-                "price": {
-                    "type": "integer"
-                }
-            }
-        }
-    }
-}`
-
-type swaggerInfo struct {
-	Version     string
-	Host        string
-	BasePath    string
-	Schemes     []string
-	Title       string
-	Description string
-}
-
-// SwaggerInfo holds exported Swagger Info so clients can modify it
-var SwaggerInfo = swaggerInfo{
-	Version:     "1.0",
-	Host:        "52.77.204.112:3000",
-	BasePath:    "",
-	Schemes:     []string{},
-	Title:       "Fatbellies API",
-	Description: "Project Assignment Xcidic.",
-}
-
-type s struct{}
-
-func (s *s) ReadDoc() string {
-	sInfo := SwaggerInfo
-	sInfo.Description = strings.Replace(sInfo.Description, "\n", "\\n", -1)
-
-	t, err := template.New("swagger_info").Funcs(template.FuncMap{
-		"marshal": func(v interface{}) string {
-			a, _ := json.Marshal(v)
-			return string(a)
-		},
-	}).Parse(doc)
-	if err != nil {
-		return doc
-	}
-
-	var tpl bytes.Buffer
-	if err := t.Execute(&tpl, sInfo); err != nil {
-		return doc
-	}
-
-	return tpl.String()
-}
-
-func init() {
-	swag.Register(swag.Name, &s{})
-}
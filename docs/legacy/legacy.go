@@ -0,0 +1,8 @@
+// Package legacy holds the API documentation of the deprecated unversioned
+// /api routes, frozen when /api/v1 replaced them.
+package legacy
+
+import _ "embed"
+
+//go:embed swagger.json
+var Swagger []byte
@@ -1,26 +1,71 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
+	"os"
+	"time"
 
-	_ "github.com/iamaul/fatbellies/docs"
+	"github.com/iamaul/fatbellies/docs/legacy"
+	_ "github.com/iamaul/fatbellies/docs/v1"
 	echoSwagger "github.com/swaggo/echo-swagger"
 
+	bg "github.com/iamaul/fatbellies/app/branch/delivery/grpc"
 	bh "github.com/iamaul/fatbellies/app/branch/delivery/http"
 	br "github.com/iamaul/fatbellies/app/branch/repository"
 	bu "github.com/iamaul/fatbellies/app/branch/usecase"
 
+	mpg "github.com/iamaul/fatbellies/app/meal_plan/delivery/grpc"
 	mph "github.com/iamaul/fatbellies/app/meal_plan/delivery/http"
 	mpr "github.com/iamaul/fatbellies/app/meal_plan/repository"
 	mpu "github.com/iamaul/fatbellies/app/meal_plan/usecase"
 
+	bkh "github.com/iamaul/fatbellies/app/bulk/delivery/http"
+	bku "github.com/iamaul/fatbellies/app/bulk/usecase"
+
+	nc "github.com/iamaul/fatbellies/app/notification/channel"
+	nr "github.com/iamaul/fatbellies/app/notification/repository"
+	nu "github.com/iamaul/fatbellies/app/notification/usecase"
+
+	wh "github.com/iamaul/fatbellies/app/webhook/delivery/http"
+	wr "github.com/iamaul/fatbellies/app/webhook/repository"
+	wu "github.com/iamaul/fatbellies/app/webhook/usecase"
+
+	gh "github.com/iamaul/fatbellies/app/graph/delivery/http"
+
+	avh "github.com/iamaul/fatbellies/app/availability/delivery/http"
+	avr "github.com/iamaul/fatbellies/app/availability/repository"
+	avu "github.com/iamaul/fatbellies/app/availability/usecase"
+
+	sh "github.com/iamaul/fatbellies/app/search/delivery/http"
+	sr "github.com/iamaul/fatbellies/app/search/repository"
+	su "github.com/iamaul/fatbellies/app/search/usecase"
+
+	ah "github.com/iamaul/fatbellies/app/audit/delivery/http"
+	ar "github.com/iamaul/fatbellies/app/audit/repository"
+	au "github.com/iamaul/fatbellies/app/audit/usecase"
+
+	uowr "github.com/iamaul/fatbellies/app/uow/repository"
+
+	er "github.com/iamaul/fatbellies/app/events/repository"
+	eu "github.com/iamaul/fatbellies/app/events/usecase"
+
+	"github.com/iamaul/fatbellies/app/events"
+	"github.com/iamaul/fatbellies/app/notification"
 	"github.com/iamaul/fatbellies/config"
 	"github.com/iamaul/fatbellies/config/database"
 	"github.com/iamaul/fatbellies/config/migrations"
+	pb "github.com/iamaul/fatbellies/proto/fatbellies/v1"
+	"github.com/iamaul/fatbellies/utils"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
 // @title Fatbellies API
@@ -35,29 +80,47 @@ import (
 // @license.url http://www.apache.org/licenses/LICENSE-2.0.html
 
 // @host 52.77.204.112:3000
+// @BasePath /api/v1
 
 func main() {
 	config := config.NewConfig()
 
+	if len(os.Args) > 1 {
+		if err := runCommand(config, os.Args[1:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	dbConnection, err := database.ConnectDatabase(config)
 	if err != nil {
 		log.Fatal(err)
 	}
 
 	// Migrate tables
-	migrations.Migrate(dbConnection)
+	if config.AutoMigrate {
+		if _, err := migrations.Up(dbConnection); err != nil {
+			log.Fatal(err)
+		}
+	}
 
-	// ToDo: Redis connection
+	// Redis carries availability changes between replicas. Only live
+	// availability needs it and the client keeps reconnecting, so a failed
+	// ping, which ConnectRedis logs, does not stop the API from starting.
+	redisClient, _ := utils.ConnectRedis(config)
 
 	e := echo.New()
+	e.HTTPErrorHandler = utils.HTTPErrorHandler
 
 	// appMiddl := middleware.InitAppMiddleware(config.AppName)
 	// e.Use(appMiddl.CORS)
 	corsMiddl := middleware.CORSConfig{
-		AllowOrigins: []string{"*"},
-		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderXRequestedWith},
+		AllowOrigins:  []string{"*"},
+		AllowHeaders:  []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderXRequestedWith, utils.HeaderActor, utils.HeaderIfMatch, utils.HeaderIfNoneMatch, utils.HeaderAcceptLanguage},
+		ExposeHeaders: []string{utils.HeaderETag, utils.HeaderDeprecation, utils.HeaderSunset, utils.HeaderLink, utils.HeaderContentLanguage},
 	}
 	e.Use(middleware.CORSWithConfig(corsMiddl))
+	e.Use(utils.Localize)
 
 	e.GET("/", func(c echo.Context) error {
 		return c.JSON(http.StatusOK, map[string]interface{}{
@@ -66,20 +129,148 @@ func main() {
 		})
 	})
 
+	timeoutContext := time.Duration(config.ContextTimeout) * time.Second
+	unitOfWork := uowr.NewUnitOfWork(dbConnection)
+
 	// Branch
 	branchRepo := br.NewBranchRepository(dbConnection)
-	branchCase := bu.NewBranchUsecase(branchRepo)
+	branchCase := bu.NewBranchUsecase(branchRepo, unitOfWork, timeoutContext)
 	// Plan
 	mealPlanRepo := mpr.NewMealPlanRepository(dbConnection)
-	mealPlanCase := mpu.NewMealPlanUsecase(mealPlanRepo)
+	mealPlanCase := mpu.NewMealPlanUsecase(mealPlanRepo, unitOfWork, timeoutContext)
+	// Bulk import and export
+	bulkCase := bku.NewBulkUsecase(branchRepo, mealPlanRepo, unitOfWork, time.Duration(config.BulkTimeout)*time.Second)
+	// Search
+	searchRepo := sr.NewSearchRepository(dbConnection)
+	searchCase := su.NewSearchUsecase(searchRepo, timeoutContext)
+	// Webhook
+	webhookRepo := wr.NewWebhookRepository(dbConnection)
+	webhookCase := wu.NewWebhookUsecase(webhookRepo, config.WebhookAllowHTTP, timeoutContext)
+	go utils.RunEvery("webhook", time.Duration(config.WebhookInterval)*time.Second, webhookCase.Dispatch)
+	// Audit
+	auditRepo := ar.NewAuditRepository(dbConnection)
+	auditCase := au.NewAuditUsecase(auditRepo, timeoutContext)
+
+	// Live availability
+	availabilityRepo := avr.NewRedisAvailabilityRepository(redisClient)
+	availabilityCase := avu.NewAvailabilityUsecase(availabilityRepo, mealPlanRepo, timeoutContext)
+	go availabilityCase.Run()
+
+	// Domain events, relayed from the outbox once their write has committed
+	dispatcher := events.NewDispatcher()
+	dispatcher.SubscribeAll(webhookCase.HandleEvent)
+	dispatcher.SubscribeAll(auditCase.HandleEvent)
+	dispatcher.SubscribeAll(availabilityCase.HandleEvent)
+	outboxRepo := er.NewOutboxRepository(dbConnection)
+	relayCase := eu.NewRelayUsecase(outboxRepo, dispatcher, time.Duration(config.OutboxRetentionHours)*time.Hour, time.Duration(config.PurgeInterval)*time.Second)
+	go utils.RunEvery("event-relay", time.Duration(config.EventRelayInterval)*time.Second, relayCase.Relay)
+
+	// Notification
+	channels, err := notificationChannels(config)
+	if err != nil {
+		log.Fatal(err)
+	}
+	notificationRepo := nr.NewNotificationRepository(dbConnection)
+	notificationCase := nu.NewNotificationUsecase(notificationRepo, channels...)
+	go utils.RunEvery("notification", time.Duration(config.NotificationInterval)*time.Second, notificationCase.Dispatch)
 
 	// Branch
 	bh.NewBranchHandler(e, branchCase)
 	// Plan
 	mph.NewMealPlanHandler(e, mealPlanCase)
+	// GraphQL reads over branches and meal plans
+	gh.NewGraphHandler(e, branchCase, mealPlanCase)
+	// Bulk import and export
+	bkh.NewBulkHandler(e, bulkCase)
+	// Search
+	sh.NewSearchHandler(e, searchCase)
+	// Webhook
+	wh.NewWebhookHandler(e, webhookCase)
+	// Audit
+	ah.NewAuditHandler(e, auditCase)
+	// Live availability
+	avh.NewAvailabilityHandler(e, availabilityCase)
+
+	// gRPC for internal services, on its own port
+	auth, err := utils.NewGRPCAuth(config.GRPCAPIKeys)
+	if err != nil {
+		log.Fatal(err)
+	}
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(auth.Unary, utils.GRPCUnaryLogger),
+		grpc.ChainStreamInterceptor(auth.Stream, utils.GRPCStreamLogger),
+	)
+	bg.NewBranchServer(grpcServer, branchCase)
+	mpg.NewMealPlanServer(grpcServer, mealPlanCase)
+	healthServer := health.NewServer()
+	for _, service := range []string{pb.BranchService_ServiceDesc.ServiceName, pb.MealPlanService_ServiceDesc.ServiceName, pb.AvailabilityService_ServiceDesc.ServiceName} {
+		healthServer.SetServingStatus(service, healthpb.HealthCheckResponse_SERVING)
+	}
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	reflection.Register(grpcServer)
 
-	// Swagger docs
-	e.GET("/api/docs/*any", echoSwagger.WrapHandler)
+	grpcListener, err := net.Listen("tcp", config.GRPCPort)
+	if err != nil {
+		log.Fatal(err)
+	}
+	go func() {
+		log.Fatal(grpcServer.Serve(grpcListener))
+	}()
+
+	// Retention of soft deleted branches and meal plans
+	go utils.RunEvery("purge", time.Duration(config.PurgeInterval)*time.Second, func() error {
+		if _, err := branchCase.Purge(context.Background(), config.RetentionDays); err != nil {
+			return err
+		}
+		_, err := mealPlanCase.Purge(context.Background(), config.RetentionDays)
+		return err
+	})
+
+	// Swagger docs, one set per API version
+	e.GET("/api/v1/docs/*any", echoSwagger.WrapHandler)
+	e.GET("/api/docs/swagger.json", func(c echo.Context) error {
+		return c.Blob(http.StatusOK, echo.MIMEApplicationJSONCharsetUTF8, legacy.Swagger)
+	})
+	e.GET("/api/docs/*any", echoSwagger.EchoWrapHandler(echoSwagger.URL("/api/docs/swagger.json")))
 
 	log.Fatal(e.Start(fmt.Sprintf(`%s`, config.AppPort)))
 }
+
+// notificationChannels falls back to the log channel for every provider that
+// is not configured, so notifications can be exercised locally.
+func notificationChannels(c *config.Configuration) ([]notification.Channel, error) {
+	var channels []notification.Channel
+
+	fallback := func(name string) error {
+		ch, err := nc.NewLogChannel(name, c.NotificationLogFile)
+		if err != nil {
+			return err
+		}
+		channels = append(channels, ch)
+		return nil
+	}
+
+	if c.SMTPHost != "" {
+		channels = append(channels, nc.NewSMTPChannel(c.SMTPHost, c.SMTPPort, c.SMTPUsername, c.SMTPPassword, c.SMTPFrom))
+	} else if err := fallback("email"); err != nil {
+		return nil, err
+	}
+
+	if c.SMSAPIURL != "" {
+		channels = append(channels, nc.NewSMSChannel(c.SMSAPIURL, c.SMSAPIKey, c.SMSSender))
+	} else if err := fallback("sms"); err != nil {
+		return nil, err
+	}
+
+	if c.PushServerKey != "" {
+		channels = append(channels, nc.NewPushChannel(c.PushAPIURL, c.PushServerKey))
+	} else if err := fallback("push"); err != nil {
+		return nil, err
+	}
+
+	if err := fallback("log"); err != nil {
+		return nil, err
+	}
+
+	return channels, nil
+}
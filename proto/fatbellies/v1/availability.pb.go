@@ -0,0 +1,389 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.24.4
+// source: fatbellies/v1/availability.proto
+
+package fatbelliesv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetBranchAvailabilityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BranchId string `protobuf:"bytes,1,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"`
+	// Only the date in the branch's timezone is used. Defaults to today.
+	Date *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+}
+
+func (x *GetBranchAvailabilityRequest) Reset() {
+	*x = GetBranchAvailabilityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fatbellies_v1_availability_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBranchAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBranchAvailabilityRequest) ProtoMessage() {}
+
+func (x *GetBranchAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fatbellies_v1_availability_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBranchAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetBranchAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_fatbellies_v1_availability_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetBranchAvailabilityRequest) GetBranchId() string {
+	if x != nil {
+		return x.BranchId
+	}
+	return ""
+}
+
+func (x *GetBranchAvailabilityRequest) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type BranchAvailability struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BranchId string              `protobuf:"bytes,1,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"`
+	Day      string              `protobuf:"bytes,2,opt,name=day,proto3" json:"day,omitempty"`
+	Slots    []*AvailabilitySlot `protobuf:"bytes,3,rep,name=slots,proto3" json:"slots,omitempty"`
+	// IANA timezone of the branch
+	Timezone string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+}
+
+func (x *BranchAvailability) Reset() {
+	*x = BranchAvailability{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fatbellies_v1_availability_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BranchAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BranchAvailability) ProtoMessage() {}
+
+func (x *BranchAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_fatbellies_v1_availability_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BranchAvailability.ProtoReflect.Descriptor instead.
+func (*BranchAvailability) Descriptor() ([]byte, []int) {
+	return file_fatbellies_v1_availability_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BranchAvailability) GetBranchId() string {
+	if x != nil {
+		return x.BranchId
+	}
+	return ""
+}
+
+func (x *BranchAvailability) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *BranchAvailability) GetSlots() []*AvailabilitySlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *BranchAvailability) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type AvailabilitySlot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MealPlanId   string                 `protobuf:"bytes,1,opt,name=meal_plan_id,json=mealPlanId,proto3" json:"meal_plan_id,omitempty"`
+	MealPlanName string                 `protobuf:"bytes,2,opt,name=meal_plan_name,json=mealPlanName,proto3" json:"meal_plan_name,omitempty"`
+	StartTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Capacity     uint32                 `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Price        uint64                 `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *AvailabilitySlot) Reset() {
+	*x = AvailabilitySlot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fatbellies_v1_availability_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvailabilitySlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilitySlot) ProtoMessage() {}
+
+func (x *AvailabilitySlot) ProtoReflect() protoreflect.Message {
+	mi := &file_fatbellies_v1_availability_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilitySlot.ProtoReflect.Descriptor instead.
+func (*AvailabilitySlot) Descriptor() ([]byte, []int) {
+	return file_fatbellies_v1_availability_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AvailabilitySlot) GetMealPlanId() string {
+	if x != nil {
+		return x.MealPlanId
+	}
+	return ""
+}
+
+func (x *AvailabilitySlot) GetMealPlanName() string {
+	if x != nil {
+		return x.MealPlanName
+	}
+	return ""
+}
+
+func (x *AvailabilitySlot) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *AvailabilitySlot) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *AvailabilitySlot) GetCapacity() uint32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *AvailabilitySlot) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+var File_fatbellies_v1_availability_proto protoreflect.FileDescriptor
+
+var file_fatbellies_v1_availability_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x66, 0x61, 0x74, 0x62, 0x65, 0x6c, 0x6c, 0x69, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0d, 0x66, 0x61, 0x74, 0x62, 0x65, 0x6c, 0x6c, 0x69, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x6b, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x12,
+	0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22,
+	0x96, 0x01, 0x0a, 0x12, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x61, 0x74, 0x62, 0x65, 0x6c, 0x6c, 0x69, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x22, 0xfe, 0x01, 0x0a, 0x10, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x20, 0x0a,
+	0x0c, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12,
+	0x24, 0x0a, 0x0e, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61,
+	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x32, 0x7e, 0x0a, 0x13, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x67, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x2b, 0x2e, 0x66, 0x61, 0x74, 0x62,
+	0x65, 0x6c, 0x6c, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x66, 0x61, 0x74, 0x62, 0x65, 0x6c, 0x6c,
+	0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x61, 0x6d, 0x61, 0x75, 0x6c, 0x2f, 0x66,
+	0x61, 0x74, 0x62, 0x65, 0x6c, 0x6c, 0x69, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x66, 0x61, 0x74, 0x62, 0x65, 0x6c, 0x6c, 0x69, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x61,
+	0x74, 0x62, 0x65, 0x6c, 0x6c, 0x69, 0x65, 0x73, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_fatbellies_v1_availability_proto_rawDescOnce sync.Once
+	file_fatbellies_v1_availability_proto_rawDescData = file_fatbellies_v1_availability_proto_rawDesc
+)
+
+func file_fatbellies_v1_availability_proto_rawDescGZIP() []byte {
+	file_fatbellies_v1_availability_proto_rawDescOnce.Do(func() {
+		file_fatbellies_v1_availability_proto_rawDescData = protoimpl.X.CompressGZIP(file_fatbellies_v1_availability_proto_rawDescData)
+	})
+	return file_fatbellies_v1_availability_proto_rawDescData
+}
+
+var file_fatbellies_v1_availability_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_fatbellies_v1_availability_proto_goTypes = []interface{}{
+	(*GetBranchAvailabilityRequest)(nil), // 0: fatbellies.v1.GetBranchAvailabilityRequest
+	(*BranchAvailability)(nil),           // 1: fatbellies.v1.BranchAvailability
+	(*AvailabilitySlot)(nil),             // 2: fatbellies.v1.AvailabilitySlot
+	(*timestamppb.Timestamp)(nil),        // 3: google.protobuf.Timestamp
+}
+var file_fatbellies_v1_availability_proto_depIdxs = []int32{
+	3, // 0: fatbellies.v1.GetBranchAvailabilityRequest.date:type_name -> google.protobuf.Timestamp
+	2, // 1: fatbellies.v1.BranchAvailability.slots:type_name -> fatbellies.v1.AvailabilitySlot
+	3, // 2: fatbellies.v1.AvailabilitySlot.start_time:type_name -> google.protobuf.Timestamp
+	3, // 3: fatbellies.v1.AvailabilitySlot.end_time:type_name -> google.protobuf.Timestamp
+	0, // 4: fatbellies.v1.AvailabilityService.GetBranchAvailability:input_type -> fatbellies.v1.GetBranchAvailabilityRequest
+	1, // 5: fatbellies.v1.AvailabilityService.GetBranchAvailability:output_type -> fatbellies.v1.BranchAvailability
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_fatbellies_v1_availability_proto_init() }
+func file_fatbellies_v1_availability_proto_init() {
+	if File_fatbellies_v1_availability_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_fatbellies_v1_availability_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBranchAvailabilityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fatbellies_v1_availability_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BranchAvailability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fatbellies_v1_availability_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AvailabilitySlot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_fatbellies_v1_availability_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_fatbellies_v1_availability_proto_goTypes,
+		DependencyIndexes: file_fatbellies_v1_availability_proto_depIdxs,
+		MessageInfos:      file_fatbellies_v1_availability_proto_msgTypes,
+	}.Build()
+	File_fatbellies_v1_availability_proto = out.File
+	file_fatbellies_v1_availability_proto_rawDesc = nil
+	file_fatbellies_v1_availability_proto_goTypes = nil
+	file_fatbellies_v1_availability_proto_depIdxs = nil
+}
@@ -0,0 +1,401 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.24.4
+// source: fatbellies/v1/branch.proto
+
+package fatbelliesv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	BranchService_ListBranches_FullMethodName       = "/fatbellies.v1.BranchService/ListBranches"
+	BranchService_StreamBranches_FullMethodName     = "/fatbellies.v1.BranchService/StreamBranches"
+	BranchService_GetBranch_FullMethodName          = "/fatbellies.v1.BranchService/GetBranch"
+	BranchService_NearestBranches_FullMethodName    = "/fatbellies.v1.BranchService/NearestBranches"
+	BranchService_CreateBranch_FullMethodName       = "/fatbellies.v1.BranchService/CreateBranch"
+	BranchService_UpdateBranch_FullMethodName       = "/fatbellies.v1.BranchService/UpdateBranch"
+	BranchService_DeleteBranch_FullMethodName       = "/fatbellies.v1.BranchService/DeleteBranch"
+	BranchService_SetBranchMealPlans_FullMethodName = "/fatbellies.v1.BranchService/SetBranchMealPlans"
+)
+
+// BranchServiceClient is the client API for BranchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BranchServiceClient interface {
+	ListBranches(ctx context.Context, in *ListBranchesRequest, opts ...grpc.CallOption) (*ListBranchesResponse, error)
+	// StreamBranches sends every branch matching the request, page by page
+	StreamBranches(ctx context.Context, in *StreamBranchesRequest, opts ...grpc.CallOption) (BranchService_StreamBranchesClient, error)
+	GetBranch(ctx context.Context, in *GetBranchRequest, opts ...grpc.CallOption) (*Branch, error)
+	NearestBranches(ctx context.Context, in *NearestBranchesRequest, opts ...grpc.CallOption) (*NearestBranchesResponse, error)
+	CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*Branch, error)
+	UpdateBranch(ctx context.Context, in *UpdateBranchRequest, opts ...grpc.CallOption) (*Branch, error)
+	DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SetBranchMealPlans replaces the meal plans a branch offers
+	SetBranchMealPlans(ctx context.Context, in *SetBranchMealPlansRequest, opts ...grpc.CallOption) (*MealPlanLinkChanges, error)
+}
+
+type branchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBranchServiceClient(cc grpc.ClientConnInterface) BranchServiceClient {
+	return &branchServiceClient{cc}
+}
+
+func (c *branchServiceClient) ListBranches(ctx context.Context, in *ListBranchesRequest, opts ...grpc.CallOption) (*ListBranchesResponse, error) {
+	out := new(ListBranchesResponse)
+	err := c.cc.Invoke(ctx, BranchService_ListBranches_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *branchServiceClient) StreamBranches(ctx context.Context, in *StreamBranchesRequest, opts ...grpc.CallOption) (BranchService_StreamBranchesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BranchService_ServiceDesc.Streams[0], BranchService_StreamBranches_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &branchServiceStreamBranchesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BranchService_StreamBranchesClient interface {
+	Recv() (*Branch, error)
+	grpc.ClientStream
+}
+
+type branchServiceStreamBranchesClient struct {
+	grpc.ClientStream
+}
+
+func (x *branchServiceStreamBranchesClient) Recv() (*Branch, error) {
+	m := new(Branch)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *branchServiceClient) GetBranch(ctx context.Context, in *GetBranchRequest, opts ...grpc.CallOption) (*Branch, error) {
+	out := new(Branch)
+	err := c.cc.Invoke(ctx, BranchService_GetBranch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *branchServiceClient) NearestBranches(ctx context.Context, in *NearestBranchesRequest, opts ...grpc.CallOption) (*NearestBranchesResponse, error) {
+	out := new(NearestBranchesResponse)
+	err := c.cc.Invoke(ctx, BranchService_NearestBranches_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *branchServiceClient) CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*Branch, error) {
+	out := new(Branch)
+	err := c.cc.Invoke(ctx, BranchService_CreateBranch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *branchServiceClient) UpdateBranch(ctx context.Context, in *UpdateBranchRequest, opts ...grpc.CallOption) (*Branch, error) {
+	out := new(Branch)
+	err := c.cc.Invoke(ctx, BranchService_UpdateBranch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *branchServiceClient) DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BranchService_DeleteBranch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *branchServiceClient) SetBranchMealPlans(ctx context.Context, in *SetBranchMealPlansRequest, opts ...grpc.CallOption) (*MealPlanLinkChanges, error) {
+	out := new(MealPlanLinkChanges)
+	err := c.cc.Invoke(ctx, BranchService_SetBranchMealPlans_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BranchServiceServer is the server API for BranchService service.
+// All implementations must embed UnimplementedBranchServiceServer
+// for forward compatibility
+type BranchServiceServer interface {
+	ListBranches(context.Context, *ListBranchesRequest) (*ListBranchesResponse, error)
+	// StreamBranches sends every branch matching the request, page by page
+	StreamBranches(*StreamBranchesRequest, BranchService_StreamBranchesServer) error
+	GetBranch(context.Context, *GetBranchRequest) (*Branch, error)
+	NearestBranches(context.Context, *NearestBranchesRequest) (*NearestBranchesResponse, error)
+	CreateBranch(context.Context, *CreateBranchRequest) (*Branch, error)
+	UpdateBranch(context.Context, *UpdateBranchRequest) (*Branch, error)
+	DeleteBranch(context.Context, *DeleteBranchRequest) (*emptypb.Empty, error)
+	// SetBranchMealPlans replaces the meal plans a branch offers
+	SetBranchMealPlans(context.Context, *SetBranchMealPlansRequest) (*MealPlanLinkChanges, error)
+	mustEmbedUnimplementedBranchServiceServer()
+}
+
+// UnimplementedBranchServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBranchServiceServer struct {
+}
+
+func (UnimplementedBranchServiceServer) ListBranches(context.Context, *ListBranchesRequest) (*ListBranchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBranches not implemented")
+}
+func (UnimplementedBranchServiceServer) StreamBranches(*StreamBranchesRequest, BranchService_StreamBranchesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBranches not implemented")
+}
+func (UnimplementedBranchServiceServer) GetBranch(context.Context, *GetBranchRequest) (*Branch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBranch not implemented")
+}
+func (UnimplementedBranchServiceServer) NearestBranches(context.Context, *NearestBranchesRequest) (*NearestBranchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NearestBranches not implemented")
+}
+func (UnimplementedBranchServiceServer) CreateBranch(context.Context, *CreateBranchRequest) (*Branch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBranch not implemented")
+}
+func (UnimplementedBranchServiceServer) UpdateBranch(context.Context, *UpdateBranchRequest) (*Branch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBranch not implemented")
+}
+func (UnimplementedBranchServiceServer) DeleteBranch(context.Context, *DeleteBranchRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBranch not implemented")
+}
+func (UnimplementedBranchServiceServer) SetBranchMealPlans(context.Context, *SetBranchMealPlansRequest) (*MealPlanLinkChanges, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBranchMealPlans not implemented")
+}
+func (UnimplementedBranchServiceServer) mustEmbedUnimplementedBranchServiceServer() {}
+
+// UnsafeBranchServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BranchServiceServer will
+// result in compilation errors.
+type UnsafeBranchServiceServer interface {
+	mustEmbedUnimplementedBranchServiceServer()
+}
+
+func RegisterBranchServiceServer(s grpc.ServiceRegistrar, srv BranchServiceServer) {
+	s.RegisterService(&BranchService_ServiceDesc, srv)
+}
+
+func _BranchService_ListBranches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBranchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BranchServiceServer).ListBranches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BranchService_ListBranches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BranchServiceServer).ListBranches(ctx, req.(*ListBranchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BranchService_StreamBranches_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamBranchesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BranchServiceServer).StreamBranches(m, &branchServiceStreamBranchesServer{stream})
+}
+
+type BranchService_StreamBranchesServer interface {
+	Send(*Branch) error
+	grpc.ServerStream
+}
+
+type branchServiceStreamBranchesServer struct {
+	grpc.ServerStream
+}
+
+func (x *branchServiceStreamBranchesServer) Send(m *Branch) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BranchService_GetBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BranchServiceServer).GetBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BranchService_GetBranch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BranchServiceServer).GetBranch(ctx, req.(*GetBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BranchService_NearestBranches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NearestBranchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BranchServiceServer).NearestBranches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BranchService_NearestBranches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BranchServiceServer).NearestBranches(ctx, req.(*NearestBranchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BranchService_CreateBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BranchServiceServer).CreateBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BranchService_CreateBranch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BranchServiceServer).CreateBranch(ctx, req.(*CreateBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BranchService_UpdateBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BranchServiceServer).UpdateBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BranchService_UpdateBranch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BranchServiceServer).UpdateBranch(ctx, req.(*UpdateBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BranchService_DeleteBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BranchServiceServer).DeleteBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BranchService_DeleteBranch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BranchServiceServer).DeleteBranch(ctx, req.(*DeleteBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BranchService_SetBranchMealPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBranchMealPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BranchServiceServer).SetBranchMealPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BranchService_SetBranchMealPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BranchServiceServer).SetBranchMealPlans(ctx, req.(*SetBranchMealPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BranchService_ServiceDesc is the grpc.ServiceDesc for BranchService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BranchService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fatbellies.v1.BranchService",
+	HandlerType: (*BranchServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListBranches",
+			Handler:    _BranchService_ListBranches_Handler,
+		},
+		{
+			MethodName: "GetBranch",
+			Handler:    _BranchService_GetBranch_Handler,
+		},
+		{
+			MethodName: "NearestBranches",
+			Handler:    _BranchService_NearestBranches_Handler,
+		},
+		{
+			MethodName: "CreateBranch",
+			Handler:    _BranchService_CreateBranch_Handler,
+		},
+		{
+			MethodName: "UpdateBranch",
+			Handler:    _BranchService_UpdateBranch_Handler,
+		},
+		{
+			MethodName: "DeleteBranch",
+			Handler:    _BranchService_DeleteBranch_Handler,
+		},
+		{
+			MethodName: "SetBranchMealPlans",
+			Handler:    _BranchService_SetBranchMealPlans_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBranches",
+			Handler:       _BranchService_StreamBranches_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "fatbellies/v1/branch.proto",
+}
@@ -0,0 +1,1040 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.24.4
+// source: fatbellies/v1/meal_plan.proto
+
+package fatbelliesv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MealPlanOrder int32
+
+const (
+	MealPlanOrder_MEAL_PLAN_ORDER_UNSPECIFIED     MealPlanOrder = 0
+	MealPlanOrder_MEAL_PLAN_ORDER_CREATED_AT_DESC MealPlanOrder = 1
+	MealPlanOrder_MEAL_PLAN_ORDER_CREATED_AT_ASC  MealPlanOrder = 2
+	MealPlanOrder_MEAL_PLAN_ORDER_NAME_ASC        MealPlanOrder = 3
+	MealPlanOrder_MEAL_PLAN_ORDER_NAME_DESC       MealPlanOrder = 4
+	MealPlanOrder_MEAL_PLAN_ORDER_PRICE_ASC       MealPlanOrder = 5
+	MealPlanOrder_MEAL_PLAN_ORDER_PRICE_DESC      MealPlanOrder = 6
+)
+
+// Enum value maps for MealPlanOrder.
+var (
+	MealPlanOrder_name = map[int32]string{
+		0: "MEAL_PLAN_ORDER_UNSPECIFIED",
+		1: "MEAL_PLAN_ORDER_CREATED_AT_DESC",
+		2: "MEAL_PLAN_ORDER_CREATED_AT_ASC",
+		3: "MEAL_PLAN_ORDER_NAME_ASC",
+		4: "MEAL_PLAN_ORDER_NAME_DESC",
+		5: "MEAL_PLAN_ORDER_PRICE_ASC",
+		6: "MEAL_PLAN_ORDER_PRICE_DESC",
+	}
+	MealPlanOrder_value = map[string]int32{
+		"MEAL_PLAN_ORDER_UNSPECIFIED":     0,
+		"MEAL_PLAN_ORDER_CREATED_AT_DESC": 1,
+		"MEAL_PLAN_ORDER_CREATED_AT_ASC":  2,
+		"MEAL_PLAN_ORDER_NAME_ASC":        3,
+		"MEAL_PLAN_ORDER_NAME_DESC":       4,
+		"MEAL_PLAN_ORDER_PRICE_ASC":       5,
+		"MEAL_PLAN_ORDER_PRICE_DESC":      6,
+	}
+)
+
+func (x MealPlanOrder) Enum() *MealPlanOrder {
+	p := new(MealPlanOrder)
+	*p = x
+	return p
+}
+
+func (x MealPlanOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MealPlanOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_fatbellies_v1_meal_plan_proto_enumTypes[0].Descriptor()
+}
+
+func (MealPlanOrder) Type() protoreflect.EnumType {
+	return &file_fatbellies_v1_meal_plan_proto_enumTypes[0]
+}
+
+func (x MealPlanOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MealPlanOrder.Descriptor instead.
+func (MealPlanOrder) EnumDescriptor() ([]byte, []int) {
+	return file_fatbellies_v1_meal_plan_proto_rawDescGZIP(), []int{0}
+}
+
+type MealPlan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MaxCapacity uint32 `protobuf:"varint,3,opt,name=max_capacity,json=maxCapacity,proto3" json:"max_capacity,omitempty"`
+	Price       uint64 `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	// Weekday the session is held on every week
+	Day string `protobuf:"bytes,5,opt,name=day,proto3" json:"day,omitempty"`
+	// The session under way or else the next one
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Version   uint64                 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// IANA timezone the session is held in
+	Timezone string `protobuf:"bytes,11,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Local times of day the session starts and ends, such as "18:30:00"
+	StartClock string `protobuf:"bytes,12,opt,name=start_clock,json=startClock,proto3" json:"start_clock,omitempty"`
+	EndClock   string `protobuf:"bytes,13,opt,name=end_clock,json=endClock,proto3" json:"end_clock,omitempty"`
+}
+
+func (x *MealPlan) Reset() {
+	*x = MealPlan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fatbellies_v1_meal_plan_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MealPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealPlan) ProtoMessage() {}
+
+func (x *MealPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_fatbellies_v1_meal_plan_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealPlan.ProtoReflect.Descriptor instead.
+func (*MealPlan) Descriptor() ([]byte, []int) {
+	return file_fatbellies_v1_meal_plan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MealPlan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MealPlan) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MealPlan) GetMaxCapacity() uint32 {
+	if x != nil {
+		return x.MaxCapacity
+	}
+	return 0
+}
+
+func (x *MealPlan) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *MealPlan) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *MealPlan) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *MealPlan) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *MealPlan) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *MealPlan) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MealPlan) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *MealPlan) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *MealPlan) GetStartClock() string {
+	if x != nil {
+		return x.StartClock
+	}
+	return ""
+}
+
+func (x *MealPlan) GetEndClock() string {
+	if x != nil {
+		return x.EndClock
+	}
+	return ""
+}
+
+type ListMealPlansRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Defaults to 10, at most 100
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Starts at 1
+	Page    int32         `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	OrderBy MealPlanOrder `protobuf:"varint,3,opt,name=order_by,json=orderBy,proto3,enum=fatbellies.v1.MealPlanOrder" json:"order_by,omitempty"`
+	// Keeps the meal plans whose name contains it
+	Search string `protobuf:"bytes,4,opt,name=search,proto3" json:"search,omitempty"`
+}
+
+func (x *ListMealPlansRequest) Reset() {
+	*x = ListMealPlansRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fatbellies_v1_meal_plan_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMealPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMealPlansRequest) ProtoMessage() {}
+
+func (x *ListMealPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fatbellies_v1_meal_plan_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMealPlansRequest.ProtoReflect.Descriptor instead.
+func (*ListMealPlansRequest) Descriptor() ([]byte, []int) {
+	return file_fatbellies_v1_meal_plan_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListMealPlansRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMealPlansRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMealPlansRequest) GetOrderBy() MealPlanOrder {
+	if x != nil {
+		return x.OrderBy
+	}
+	return MealPlanOrder_MEAL_PLAN_ORDER_UNSPECIFIED
+}
+
+func (x *ListMealPlansRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+type ListMealPlansResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MealPlans []*MealPlan `protobuf:"bytes,1,rep,name=meal_plans,json=mealPlans,proto3" json:"meal_plans,omitempty"`
+}
+
+func (x *ListMealPlansResponse) Reset() {
+	*x = ListMealPlansResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fatbellies_v1_meal_plan_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMealPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMealPlansResponse) ProtoMessage() {}
+
+func (x *ListMealPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fatbellies_v1_meal_plan_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMealPlansResponse.ProtoReflect.Descriptor instead.
+func (*ListMealPlansResponse) Descriptor() ([]byte, []int) {
+	return file_fatbellies_v1_meal_plan_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListMealPlansResponse) GetMealPlans() []*MealPlan {
+	if x != nil {
+		return x.MealPlans
+	}
+	return nil
+}
+
+type StreamMealPlansRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderBy MealPlanOrder `protobuf:"varint,1,opt,name=order_by,json=orderBy,proto3,enum=fatbellies.v1.MealPlanOrder" json:"order_by,omitempty"`
+	Search  string        `protobuf:"bytes,2,opt,name=search,proto3" json:"search,omitempty"`
+}
+
+func (x *StreamMealPlansRequest) Reset() {
+	*x = StreamMealPlansRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fatbellies_v1_meal_plan_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamMealPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMealPlansRequest) ProtoMessage() {}
+
+func (x *StreamMealPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fatbellies_v1_meal_plan_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMealPlansRequest.ProtoReflect.Descriptor instead.
+func (*StreamMealPlansRequest) Descriptor() ([]byte, []int) {
+	return file_fatbellies_v1_meal_plan_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StreamMealPlansRequest) GetOrderBy() MealPlanOrder {
+	if x != nil {
+		return x.OrderBy
+	}
+	return MealPlanOrder_MEAL_PLAN_ORDER_UNSPECIFIED
+}
+
+func (x *StreamMealPlansRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+type GetMealPlanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetMealPlanRequest) Reset() {
+	*x = GetMealPlanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fatbellies_v1_meal_plan_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMealPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMealPlanRequest) ProtoMessage() {}
+
+func (x *GetMealPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fatbellies_v1_meal_plan_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMealPlanRequest.ProtoReflect.Descriptor instead.
+func (*GetMealPlanRequest) Descriptor() ([]byte, []int) {
+	return file_fatbellies_v1_meal_plan_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetMealPlanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CreateMealPlanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MaxCapacity uint32 `protobuf:"varint,2,opt,name=max_capacity,json=maxCapacity,proto3" json:"max_capacity,omitempty"`
+	Price       uint64 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	// Weekday the session is held on every week
+	Day string `protobuf:"bytes,4,opt,name=day,proto3" json:"day,omitempty"`
+	// Any session; only its local times of day are kept
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *CreateMealPlanRequest) Reset() {
+	*x = CreateMealPlanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fatbellies_v1_meal_plan_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateMealPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMealPlanRequest) ProtoMessage() {}
+
+func (x *CreateMealPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fatbellies_v1_meal_plan_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMealPlanRequest.ProtoReflect.Descriptor instead.
+func (*CreateMealPlanRequest) Descriptor() ([]byte, []int) {
+	return file_fatbellies_v1_meal_plan_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateMealPlanRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateMealPlanRequest) GetMaxCapacity() uint32 {
+	if x != nil {
+		return x.MaxCapacity
+	}
+	return 0
+}
+
+func (x *CreateMealPlanRequest) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateMealPlanRequest) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *CreateMealPlanRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateMealPlanRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type UpdateMealPlanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The version the update is based on, as returned by the last read
+	Version     uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	MaxCapacity uint32 `protobuf:"varint,4,opt,name=max_capacity,json=maxCapacity,proto3" json:"max_capacity,omitempty"`
+	Price       uint64 `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	// Weekday the session is held on every week
+	Day string `protobuf:"bytes,6,opt,name=day,proto3" json:"day,omitempty"`
+	// Any session; only its local times of day are kept
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *UpdateMealPlanRequest) Reset() {
+	*x = UpdateMealPlanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fatbellies_v1_meal_plan_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMealPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMealPlanRequest) ProtoMessage() {}
+
+func (x *UpdateMealPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fatbellies_v1_meal_plan_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMealPlanRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMealPlanRequest) Descriptor() ([]byte, []int) {
+	return file_fatbellies_v1_meal_plan_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateMealPlanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateMealPlanRequest) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateMealPlanRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateMealPlanRequest) GetMaxCapacity() uint32 {
+	if x != nil {
+		return x.MaxCapacity
+	}
+	return 0
+}
+
+func (x *UpdateMealPlanRequest) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *UpdateMealPlanRequest) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *UpdateMealPlanRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *UpdateMealPlanRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type DeleteMealPlanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *DeleteMealPlanRequest) Reset() {
+	*x = DeleteMealPlanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fatbellies_v1_meal_plan_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteMealPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMealPlanRequest) ProtoMessage() {}
+
+func (x *DeleteMealPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fatbellies_v1_meal_plan_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMealPlanRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMealPlanRequest) Descriptor() ([]byte, []int) {
+	return file_fatbellies_v1_meal_plan_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteMealPlanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteMealPlanRequest) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+var File_fatbellies_v1_meal_plan_proto protoreflect.FileDescriptor
+
+var file_fatbellies_v1_meal_plan_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x66, 0x61, 0x74, 0x62, 0x65, 0x6c, 0x6c, 0x69, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f,
+	0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0d, 0x66, 0x61, 0x74, 0x62, 0x65, 0x6c, 0x6c, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x1b,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd5, 0x03, 0x0a,
+	0x08, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x5f, 0x63,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x43,
+	0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x98, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x61,
+	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x37,
+	0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1c, 0x2e, 0x66, 0x61, 0x74, 0x62, 0x65, 0x6c, 0x6c, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x22,
+	0x4f, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x6d, 0x65, 0x61, 0x6c,
+	0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66,
+	0x61, 0x74, 0x62, 0x65, 0x6c, 0x6c, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x61,
+	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x09, 0x6d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x73,
+	0x22, 0x69, 0x0a, 0x16, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c,
+	0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x66,
+	0x61, 0x74, 0x62, 0x65, 0x6c, 0x6c, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x61,
+	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x22, 0x24, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0xe8, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c,
+	0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69,
+	0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x92, 0x02, 0x0a,
+	0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x43,
+	0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x64, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12,
+	0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x41, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50,
+	0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x2a, 0xf5, 0x01, 0x0a, 0x0d, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61,
+	0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50,
+	0x4c, 0x41, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x4d, 0x45, 0x41, 0x4c, 0x5f,
+	0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54,
+	0x45, 0x44, 0x5f, 0x41, 0x54, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e,
+	0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
+	0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x02,
+	0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x03, 0x12, 0x1d,
+	0x0a, 0x19, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x04, 0x12, 0x1d, 0x0a,
+	0x19, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x05, 0x12, 0x1e, 0x0a, 0x1a,
+	0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
+	0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x06, 0x32, 0xff, 0x03, 0x0a,
+	0x0f, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x5a, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
+	0x73, 0x12, 0x23, 0x2e, 0x66, 0x61, 0x74, 0x62, 0x65, 0x6c, 0x6c, 0x69, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x66, 0x61, 0x74, 0x62, 0x65, 0x6c, 0x6c,
+	0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50,
+	0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0f,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x12,
+	0x25, 0x2e, 0x66, 0x61, 0x74, 0x62, 0x65, 0x6c, 0x6c, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x66, 0x61, 0x74, 0x62, 0x65, 0x6c, 0x6c,
+	0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x30,
+	0x01, 0x12, 0x49, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
+	0x12, 0x21, 0x2e, 0x66, 0x61, 0x74, 0x62, 0x65, 0x6c, 0x6c, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x66, 0x61, 0x74, 0x62, 0x65, 0x6c, 0x6c, 0x69, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x4f, 0x0a, 0x0e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x24,
+	0x2e, 0x66, 0x61, 0x74, 0x62, 0x65, 0x6c, 0x6c, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x66, 0x61, 0x74, 0x62, 0x65, 0x6c, 0x6c, 0x69, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x4f, 0x0a,
+	0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x12,
+	0x24, 0x2e, 0x66, 0x61, 0x74, 0x62, 0x65, 0x6c, 0x6c, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x66, 0x61, 0x74, 0x62, 0x65, 0x6c, 0x6c, 0x69,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x4e,
+	0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
+	0x12, 0x24, 0x2e, 0x66, 0x61, 0x74, 0x62, 0x65, 0x6c, 0x6c, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x3f,
+	0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x61, 0x6d,
+	0x61, 0x75, 0x6c, 0x2f, 0x66, 0x61, 0x74, 0x62, 0x65, 0x6c, 0x6c, 0x69, 0x65, 0x73, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x66, 0x61, 0x74, 0x62, 0x65, 0x6c, 0x6c, 0x69, 0x65, 0x73, 0x2f,
+	0x76, 0x31, 0x3b, 0x66, 0x61, 0x74, 0x62, 0x65, 0x6c, 0x6c, 0x69, 0x65, 0x73, 0x76, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_fatbellies_v1_meal_plan_proto_rawDescOnce sync.Once
+	file_fatbellies_v1_meal_plan_proto_rawDescData = file_fatbellies_v1_meal_plan_proto_rawDesc
+)
+
+func file_fatbellies_v1_meal_plan_proto_rawDescGZIP() []byte {
+	file_fatbellies_v1_meal_plan_proto_rawDescOnce.Do(func() {
+		file_fatbellies_v1_meal_plan_proto_rawDescData = protoimpl.X.CompressGZIP(file_fatbellies_v1_meal_plan_proto_rawDescData)
+	})
+	return file_fatbellies_v1_meal_plan_proto_rawDescData
+}
+
+var file_fatbellies_v1_meal_plan_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_fatbellies_v1_meal_plan_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_fatbellies_v1_meal_plan_proto_goTypes = []interface{}{
+	(MealPlanOrder)(0),             // 0: fatbellies.v1.MealPlanOrder
+	(*MealPlan)(nil),               // 1: fatbellies.v1.MealPlan
+	(*ListMealPlansRequest)(nil),   // 2: fatbellies.v1.ListMealPlansRequest
+	(*ListMealPlansResponse)(nil),  // 3: fatbellies.v1.ListMealPlansResponse
+	(*StreamMealPlansRequest)(nil), // 4: fatbellies.v1.StreamMealPlansRequest
+	(*GetMealPlanRequest)(nil),     // 5: fatbellies.v1.GetMealPlanRequest
+	(*CreateMealPlanRequest)(nil),  // 6: fatbellies.v1.CreateMealPlanRequest
+	(*UpdateMealPlanRequest)(nil),  // 7: fatbellies.v1.UpdateMealPlanRequest
+	(*DeleteMealPlanRequest)(nil),  // 8: fatbellies.v1.DeleteMealPlanRequest
+	(*timestamppb.Timestamp)(nil),  // 9: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),          // 10: google.protobuf.Empty
+}
+var file_fatbellies_v1_meal_plan_proto_depIdxs = []int32{
+	9,  // 0: fatbellies.v1.MealPlan.start_time:type_name -> google.protobuf.Timestamp
+	9,  // 1: fatbellies.v1.MealPlan.end_time:type_name -> google.protobuf.Timestamp
+	9,  // 2: fatbellies.v1.MealPlan.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 3: fatbellies.v1.MealPlan.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: fatbellies.v1.ListMealPlansRequest.order_by:type_name -> fatbellies.v1.MealPlanOrder
+	1,  // 5: fatbellies.v1.ListMealPlansResponse.meal_plans:type_name -> fatbellies.v1.MealPlan
+	0,  // 6: fatbellies.v1.StreamMealPlansRequest.order_by:type_name -> fatbellies.v1.MealPlanOrder
+	9,  // 7: fatbellies.v1.CreateMealPlanRequest.start_time:type_name -> google.protobuf.Timestamp
+	9,  // 8: fatbellies.v1.CreateMealPlanRequest.end_time:type_name -> google.protobuf.Timestamp
+	9,  // 9: fatbellies.v1.UpdateMealPlanRequest.start_time:type_name -> google.protobuf.Timestamp
+	9,  // 10: fatbellies.v1.UpdateMealPlanRequest.end_time:type_name -> google.protobuf.Timestamp
+	2,  // 11: fatbellies.v1.MealPlanService.ListMealPlans:input_type -> fatbellies.v1.ListMealPlansRequest
+	4,  // 12: fatbellies.v1.MealPlanService.StreamMealPlans:input_type -> fatbellies.v1.StreamMealPlansRequest
+	5,  // 13: fatbellies.v1.MealPlanService.GetMealPlan:input_type -> fatbellies.v1.GetMealPlanRequest
+	6,  // 14: fatbellies.v1.MealPlanService.CreateMealPlan:input_type -> fatbellies.v1.CreateMealPlanRequest
+	7,  // 15: fatbellies.v1.MealPlanService.UpdateMealPlan:input_type -> fatbellies.v1.UpdateMealPlanRequest
+	8,  // 16: fatbellies.v1.MealPlanService.DeleteMealPlan:input_type -> fatbellies.v1.DeleteMealPlanRequest
+	3,  // 17: fatbellies.v1.MealPlanService.ListMealPlans:output_type -> fatbellies.v1.ListMealPlansResponse
+	1,  // 18: fatbellies.v1.MealPlanService.StreamMealPlans:output_type -> fatbellies.v1.MealPlan
+	1,  // 19: fatbellies.v1.MealPlanService.GetMealPlan:output_type -> fatbellies.v1.MealPlan
+	1,  // 20: fatbellies.v1.MealPlanService.CreateMealPlan:output_type -> fatbellies.v1.MealPlan
+	1,  // 21: fatbellies.v1.MealPlanService.UpdateMealPlan:output_type -> fatbellies.v1.MealPlan
+	10, // 22: fatbellies.v1.MealPlanService.DeleteMealPlan:output_type -> google.protobuf.Empty
+	17, // [17:23] is the sub-list for method output_type
+	11, // [11:17] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_fatbellies_v1_meal_plan_proto_init() }
+func file_fatbellies_v1_meal_plan_proto_init() {
+	if File_fatbellies_v1_meal_plan_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_fatbellies_v1_meal_plan_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MealPlan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fatbellies_v1_meal_plan_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMealPlansRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fatbellies_v1_meal_plan_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMealPlansResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fatbellies_v1_meal_plan_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamMealPlansRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fatbellies_v1_meal_plan_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMealPlanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fatbellies_v1_meal_plan_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMealPlanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fatbellies_v1_meal_plan_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMealPlanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fatbellies_v1_meal_plan_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteMealPlanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_fatbellies_v1_meal_plan_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_fatbellies_v1_meal_plan_proto_goTypes,
+		DependencyIndexes: file_fatbellies_v1_meal_plan_proto_depIdxs,
+		EnumInfos:         file_fatbellies_v1_meal_plan_proto_enumTypes,
+		MessageInfos:      file_fatbellies_v1_meal_plan_proto_msgTypes,
+	}.Build()
+	File_fatbellies_v1_meal_plan_proto = out.File
+	file_fatbellies_v1_meal_plan_proto_rawDesc = nil
+	file_fatbellies_v1_meal_plan_proto_goTypes = nil
+	file_fatbellies_v1_meal_plan_proto_depIdxs = nil
+}
@@ -0,0 +1,325 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.24.4
+// source: fatbellies/v1/meal_plan.proto
+
+package fatbelliesv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	MealPlanService_ListMealPlans_FullMethodName   = "/fatbellies.v1.MealPlanService/ListMealPlans"
+	MealPlanService_StreamMealPlans_FullMethodName = "/fatbellies.v1.MealPlanService/StreamMealPlans"
+	MealPlanService_GetMealPlan_FullMethodName     = "/fatbellies.v1.MealPlanService/GetMealPlan"
+	MealPlanService_CreateMealPlan_FullMethodName  = "/fatbellies.v1.MealPlanService/CreateMealPlan"
+	MealPlanService_UpdateMealPlan_FullMethodName  = "/fatbellies.v1.MealPlanService/UpdateMealPlan"
+	MealPlanService_DeleteMealPlan_FullMethodName  = "/fatbellies.v1.MealPlanService/DeleteMealPlan"
+)
+
+// MealPlanServiceClient is the client API for MealPlanService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MealPlanServiceClient interface {
+	ListMealPlans(ctx context.Context, in *ListMealPlansRequest, opts ...grpc.CallOption) (*ListMealPlansResponse, error)
+	// StreamMealPlans sends every meal plan matching the request, page by page
+	StreamMealPlans(ctx context.Context, in *StreamMealPlansRequest, opts ...grpc.CallOption) (MealPlanService_StreamMealPlansClient, error)
+	GetMealPlan(ctx context.Context, in *GetMealPlanRequest, opts ...grpc.CallOption) (*MealPlan, error)
+	CreateMealPlan(ctx context.Context, in *CreateMealPlanRequest, opts ...grpc.CallOption) (*MealPlan, error)
+	UpdateMealPlan(ctx context.Context, in *UpdateMealPlanRequest, opts ...grpc.CallOption) (*MealPlan, error)
+	DeleteMealPlan(ctx context.Context, in *DeleteMealPlanRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type mealPlanServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMealPlanServiceClient(cc grpc.ClientConnInterface) MealPlanServiceClient {
+	return &mealPlanServiceClient{cc}
+}
+
+func (c *mealPlanServiceClient) ListMealPlans(ctx context.Context, in *ListMealPlansRequest, opts ...grpc.CallOption) (*ListMealPlansResponse, error) {
+	out := new(ListMealPlansResponse)
+	err := c.cc.Invoke(ctx, MealPlanService_ListMealPlans_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealPlanServiceClient) StreamMealPlans(ctx context.Context, in *StreamMealPlansRequest, opts ...grpc.CallOption) (MealPlanService_StreamMealPlansClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MealPlanService_ServiceDesc.Streams[0], MealPlanService_StreamMealPlans_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mealPlanServiceStreamMealPlansClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MealPlanService_StreamMealPlansClient interface {
+	Recv() (*MealPlan, error)
+	grpc.ClientStream
+}
+
+type mealPlanServiceStreamMealPlansClient struct {
+	grpc.ClientStream
+}
+
+func (x *mealPlanServiceStreamMealPlansClient) Recv() (*MealPlan, error) {
+	m := new(MealPlan)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mealPlanServiceClient) GetMealPlan(ctx context.Context, in *GetMealPlanRequest, opts ...grpc.CallOption) (*MealPlan, error) {
+	out := new(MealPlan)
+	err := c.cc.Invoke(ctx, MealPlanService_GetMealPlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealPlanServiceClient) CreateMealPlan(ctx context.Context, in *CreateMealPlanRequest, opts ...grpc.CallOption) (*MealPlan, error) {
+	out := new(MealPlan)
+	err := c.cc.Invoke(ctx, MealPlanService_CreateMealPlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealPlanServiceClient) UpdateMealPlan(ctx context.Context, in *UpdateMealPlanRequest, opts ...grpc.CallOption) (*MealPlan, error) {
+	out := new(MealPlan)
+	err := c.cc.Invoke(ctx, MealPlanService_UpdateMealPlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealPlanServiceClient) DeleteMealPlan(ctx context.Context, in *DeleteMealPlanRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MealPlanService_DeleteMealPlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MealPlanServiceServer is the server API for MealPlanService service.
+// All implementations must embed UnimplementedMealPlanServiceServer
+// for forward compatibility
+type MealPlanServiceServer interface {
+	ListMealPlans(context.Context, *ListMealPlansRequest) (*ListMealPlansResponse, error)
+	// StreamMealPlans sends every meal plan matching the request, page by page
+	StreamMealPlans(*StreamMealPlansRequest, MealPlanService_StreamMealPlansServer) error
+	GetMealPlan(context.Context, *GetMealPlanRequest) (*MealPlan, error)
+	CreateMealPlan(context.Context, *CreateMealPlanRequest) (*MealPlan, error)
+	UpdateMealPlan(context.Context, *UpdateMealPlanRequest) (*MealPlan, error)
+	DeleteMealPlan(context.Context, *DeleteMealPlanRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedMealPlanServiceServer()
+}
+
+// UnimplementedMealPlanServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedMealPlanServiceServer struct {
+}
+
+func (UnimplementedMealPlanServiceServer) ListMealPlans(context.Context, *ListMealPlansRequest) (*ListMealPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMealPlans not implemented")
+}
+func (UnimplementedMealPlanServiceServer) StreamMealPlans(*StreamMealPlansRequest, MealPlanService_StreamMealPlansServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamMealPlans not implemented")
+}
+func (UnimplementedMealPlanServiceServer) GetMealPlan(context.Context, *GetMealPlanRequest) (*MealPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMealPlan not implemented")
+}
+func (UnimplementedMealPlanServiceServer) CreateMealPlan(context.Context, *CreateMealPlanRequest) (*MealPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMealPlan not implemented")
+}
+func (UnimplementedMealPlanServiceServer) UpdateMealPlan(context.Context, *UpdateMealPlanRequest) (*MealPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMealPlan not implemented")
+}
+func (UnimplementedMealPlanServiceServer) DeleteMealPlan(context.Context, *DeleteMealPlanRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMealPlan not implemented")
+}
+func (UnimplementedMealPlanServiceServer) mustEmbedUnimplementedMealPlanServiceServer() {}
+
+// UnsafeMealPlanServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MealPlanServiceServer will
+// result in compilation errors.
+type UnsafeMealPlanServiceServer interface {
+	mustEmbedUnimplementedMealPlanServiceServer()
+}
+
+func RegisterMealPlanServiceServer(s grpc.ServiceRegistrar, srv MealPlanServiceServer) {
+	s.RegisterService(&MealPlanService_ServiceDesc, srv)
+}
+
+func _MealPlanService_ListMealPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMealPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanServiceServer).ListMealPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanService_ListMealPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanServiceServer).ListMealPlans(ctx, req.(*ListMealPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealPlanService_StreamMealPlans_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamMealPlansRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MealPlanServiceServer).StreamMealPlans(m, &mealPlanServiceStreamMealPlansServer{stream})
+}
+
+type MealPlanService_StreamMealPlansServer interface {
+	Send(*MealPlan) error
+	grpc.ServerStream
+}
+
+type mealPlanServiceStreamMealPlansServer struct {
+	grpc.ServerStream
+}
+
+func (x *mealPlanServiceStreamMealPlansServer) Send(m *MealPlan) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MealPlanService_GetMealPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMealPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanServiceServer).GetMealPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanService_GetMealPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanServiceServer).GetMealPlan(ctx, req.(*GetMealPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealPlanService_CreateMealPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMealPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanServiceServer).CreateMealPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanService_CreateMealPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanServiceServer).CreateMealPlan(ctx, req.(*CreateMealPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealPlanService_UpdateMealPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMealPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanServiceServer).UpdateMealPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanService_UpdateMealPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanServiceServer).UpdateMealPlan(ctx, req.(*UpdateMealPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealPlanService_DeleteMealPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMealPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanServiceServer).DeleteMealPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanService_DeleteMealPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanServiceServer).DeleteMealPlan(ctx, req.(*DeleteMealPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MealPlanService_ServiceDesc is the grpc.ServiceDesc for MealPlanService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MealPlanService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fatbellies.v1.MealPlanService",
+	HandlerType: (*MealPlanServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListMealPlans",
+			Handler:    _MealPlanService_ListMealPlans_Handler,
+		},
+		{
+			MethodName: "GetMealPlan",
+			Handler:    _MealPlanService_GetMealPlan_Handler,
+		},
+		{
+			MethodName: "CreateMealPlan",
+			Handler:    _MealPlanService_CreateMealPlan_Handler,
+		},
+		{
+			MethodName: "UpdateMealPlan",
+			Handler:    _MealPlanService_UpdateMealPlan_Handler,
+		},
+		{
+			MethodName: "DeleteMealPlan",
+			Handler:    _MealPlanService_DeleteMealPlan_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamMealPlans",
+			Handler:       _MealPlanService_StreamMealPlans_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "fatbellies/v1/meal_plan.proto",
+}
@@ -0,0 +1,18 @@
+package utils
+
+import "github.com/labstack/echo/v4"
+
+const (
+	HeaderActor    = "X-Actor"
+	AnonymousActor = "anonymous"
+)
+
+// Actor returns who is making the request, as sent by the admin client in the
+// X-Actor header.
+func Actor(c echo.Context) string {
+	if actor := c.Request().Header.Get(HeaderActor); actor != "" {
+		return actor
+	}
+
+	return AnonymousActor
+}
@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"math/rand"
+	"time"
+)
+
+const (
+	backoffBase = 30 * time.Second
+	backoffMax  = 6 * time.Hour
+)
+
+// Backoff returns the delay before the given retry attempt (starting at 1),
+// doubling each time up to backoffMax with up to 10% jitter.
+func Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	delay := backoffMax
+	if attempt <= 20 {
+		if d := backoffBase << uint(attempt-1); d < backoffMax {
+			delay = d
+		}
+	}
+
+	return delay + time.Duration(rand.Int63n(int64(delay)/10+1))
+}
@@ -3,4 +3,17 @@ package utils
 const (
 	BranchExists   = "Branch name already exists"
 	BranchNotFound = "Branch not found"
+
+	MealPlanNotFound       = "Meal plan not found"
+	BranchMealPlanExists   = "Meal plan is already linked to the branch"
+	BranchMealPlanNotFound = "Meal plan is not linked to the branch"
+
+	DeletedBranchNotFound   = "Deleted branch not found"
+	DeletedMealPlanNotFound = "Deleted meal plan not found"
+
+	TranslationNotFound = "Translation not found"
+
+	WebhookNotFound         = "Webhook subscription not found"
+	WebhookDeliveryNotFound = "Webhook delivery not found"
+	WebhookEventUnknown     = "Unknown webhook event type"
 )
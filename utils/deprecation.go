@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	HeaderDeprecation = "Deprecation"
+	HeaderSunset      = "Sunset"
+	HeaderLink        = "Link"
+)
+
+var (
+	// LegacyDeprecation is when the unversioned /api routes were deprecated
+	// in favour of /api/v1, and LegacySunset when they stop being served.
+	LegacyDeprecation = time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+	LegacySunset      = time.Date(2027, time.April, 19, 0, 0, 0, 0, time.UTC)
+)
+
+// Deprecated marks a legacy route with the Deprecation and Sunset headers and
+// links clients to successor, the route replacing it, unless it is empty.
+// Path parameters such as :id in successor are filled in from the request.
+func Deprecated(successor string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			header := c.Response().Header()
+			header.Set(HeaderDeprecation, fmt.Sprintf("@%d", LegacyDeprecation.Unix()))
+			header.Set(HeaderSunset, LegacySunset.Format(http.TimeFormat))
+
+			if successor != "" {
+				link := successor
+				for _, name := range c.ParamNames() {
+					link = strings.Replace(link, ":"+name, c.Param(name), 1)
+				}
+				header.Add(HeaderLink, fmt.Sprintf("<%s>; rel=\"successor-version\"", link))
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// HTTPErrorHandler renders errors returned by handlers as ResponseJSON. Domain
+// errors keep their status and code, echo errors (unknown route, bad method)
+// get a code derived from their status, and anything else is logged and
+// reported as an internal error without leaking its text. The cause of a
+// domain error is only shown when the client caused it; causes of server
+// side errors such as timeouts are logged instead. Messages of domain errors
+// are translated into the language the client accepts when possible.
+func HTTPErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		c.Logger().Error(err)
+		return
+	}
+
+	if IsTimeout(err) {
+		err = ErrTimeout.Wrap(err)
+	}
+
+	body := &ResponseJSON{Success: false}
+	locale := messageLocale(ParseAcceptLanguage(c.Request().Header.Get(HeaderAcceptLanguage)))
+
+	var domainErr *Error
+	var httpErr *echo.HTTPError
+
+	switch {
+	case errors.As(err, &domainErr) && domainErr.Kind != KindInternal:
+		body.Code = domainErr.Status()
+		body.Message = localizeMessage(locale, domainErr.Code, domainErr.Message)
+		body.ErrorCode = domainErr.Code
+		switch {
+		case domainErr.Err == nil:
+		case domainErr.ClientError():
+			body.Error = domainErr.Err.Error()
+		default:
+			c.Logger().Error(err)
+		}
+		if len(domainErr.Fields) > 0 {
+			body.Details = localizeFields(locale, domainErr.Fields)
+		}
+	case errors.As(err, &httpErr) && httpErr.Code < http.StatusInternalServerError:
+		body.Code = httpErr.Code
+		body.Message = http.StatusText(httpErr.Code)
+		body.ErrorCode = strings.ReplaceAll(strings.ToLower(body.Message), " ", "_")
+		if message, ok := httpErr.Message.(string); ok && message != body.Message {
+			body.Error = message
+		}
+	default:
+		c.Logger().Error(err)
+		body.Code = ErrInternal.Status()
+		body.Message = localizeMessage(locale, ErrInternal.Code, ErrInternal.Message)
+		body.ErrorCode = ErrInternal.Code
+	}
+
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(body.Code)
+	} else {
+		err = c.JSON(body.Code, body)
+	}
+	if err != nil {
+		c.Logger().Error(err)
+	}
+}
@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestHTTPErrorHandlerCause(t *testing.T) {
+	cause := errors.New(`pq: canceling statement due to user request`)
+
+	tests := []struct {
+		name      string
+		err       error
+		wantCode  int
+		wantError string
+	}{
+		{"client error shows its cause", ErrInvalidBody.Wrap(errors.New("unexpected EOF")), http.StatusBadRequest, "unexpected EOF"},
+		{"server error hides its cause", ErrTimeout.Wrap(cause), http.StatusGatewayTimeout, ""},
+		{"unknown error hides its text", cause, http.StatusInternalServerError, ""},
+		{"internal error hides its cause", ErrInternal.Wrap(errors.New("dial tcp: connection refused")), http.StatusInternalServerError, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+
+			HTTPErrorHandler(tt.err, c)
+
+			var body ResponseJSON
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatal(err)
+			}
+
+			got, _ := body.Error.(string)
+			if rec.Code != tt.wantCode || got != tt.wantError {
+				t.Errorf("got %d %q, want %d %q", rec.Code, got, tt.wantCode, tt.wantError)
+			}
+		})
+	}
+}
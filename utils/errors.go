@@ -0,0 +1,313 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator"
+	"github.com/lib/pq"
+)
+
+// ErrorKind classifies an Error and decides the HTTP status it renders as
+type ErrorKind int
+
+const (
+	KindInternal ErrorKind = iota
+	KindBadRequest
+	KindValidation
+	KindUnauthorized
+	KindForbidden
+	KindNotFound
+	KindConflict
+	KindUnprocessable
+	KindTimeout
+	KindPreconditionFailed
+	KindPreconditionRequired
+)
+
+var kindStatus = map[ErrorKind]int{
+	KindInternal:             http.StatusInternalServerError,
+	KindBadRequest:           http.StatusBadRequest,
+	KindValidation:           http.StatusBadRequest,
+	KindUnauthorized:         http.StatusUnauthorized,
+	KindForbidden:            http.StatusForbidden,
+	KindNotFound:             http.StatusNotFound,
+	KindConflict:             http.StatusConflict,
+	KindUnprocessable:        http.StatusUnprocessableEntity,
+	KindTimeout:              http.StatusGatewayTimeout,
+	KindPreconditionFailed:   http.StatusPreconditionFailed,
+	KindPreconditionRequired: http.StatusPreconditionRequired,
+}
+
+// FieldError describes why a single request field failed validation
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Param   string `json:"param,omitempty"`
+	Message string `json:"message"`
+}
+
+// Error is the domain error returned by usecases. Code is a stable, machine
+// readable identifier clients can switch on; Message is meant for humans.
+type Error struct {
+	Kind    ErrorKind
+	Code    string
+	Message string
+	Fields  []FieldError
+	Err     error
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is reports errors with the same code as equal, so errors.Is matches the
+// sentinels below even after Wrap.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+// Status returns the HTTP status the error is rendered with
+func (e *Error) Status() int {
+	return kindStatus[e.Kind]
+}
+
+// ClientError reports whether the request caused the error, in which case
+// its cause tells the client what to fix rather than how the server works
+func (e *Error) ClientError() bool {
+	return e.Status() < http.StatusInternalServerError
+}
+
+// Extensions is what GraphQL responses carry next to the message of the error
+func (e *Error) Extensions() map[string]interface{} {
+	ext := map[string]interface{}{"code": e.Code}
+	if len(e.Fields) > 0 {
+		ext["fields"] = e.Fields
+	}
+	return ext
+}
+
+// Wrap returns a copy of the error carrying err as its cause
+func (e *Error) Wrap(err error) *Error {
+	wrapped := *e
+	wrapped.Err = err
+	return &wrapped
+}
+
+func NewError(kind ErrorKind, code string, message string) *Error {
+	return &Error{Kind: kind, Code: code, Message: message}
+}
+
+func NotFound(code string, message string) *Error {
+	return NewError(KindNotFound, code, message)
+}
+
+func Conflict(code string, message string) *Error {
+	return NewError(KindConflict, code, message)
+}
+
+func BadRequest(code string, message string) *Error {
+	return NewError(KindBadRequest, code, message)
+}
+
+func Unprocessable(code string, message string) *Error {
+	return NewError(KindUnprocessable, code, message)
+}
+
+func Unauthorized(code string, message string) *Error {
+	return NewError(KindUnauthorized, code, message)
+}
+
+func Forbidden(code string, message string) *Error {
+	return NewError(KindForbidden, code, message)
+}
+
+var (
+	ErrInternal    = NewError(KindInternal, "internal_error", "An unexpected error has occurred")
+	ErrValidation  = NewError(KindValidation, "validation_failed", "Validation invalid")
+	ErrInvalidID   = BadRequest("invalid_id", "Invalid ID")
+	ErrInvalidBody = BadRequest("invalid_body", "Invalid request body")
+	ErrTimeout     = NewError(KindTimeout, "timeout", "The request took too long to complete")
+	ErrImportFile  = BadRequest("invalid_import_file", "Import file could not be read")
+
+	ErrPreconditionFailed   = NewError(KindPreconditionFailed, "precondition_failed", "The resource was changed since it was read")
+	ErrPreconditionRequired = NewError(KindPreconditionRequired, "precondition_required", "The If-Match header is required")
+
+	ErrBranchNotFound          = NotFound("branch_not_found", BranchNotFound)
+	ErrBranchExists            = Conflict("branch_exists", BranchExists)
+	ErrDeletedBranchNotFound   = NotFound("deleted_branch_not_found", DeletedBranchNotFound)
+	ErrMealPlanNotFound        = NotFound("meal_plan_not_found", MealPlanNotFound)
+	ErrDeletedMealPlanNotFound = NotFound("deleted_meal_plan_not_found", DeletedMealPlanNotFound)
+	ErrBranchMealPlanExists    = Conflict("branch_meal_plan_exists", BranchMealPlanExists)
+	ErrBranchMealPlanNotFound  = NotFound("branch_meal_plan_not_found", BranchMealPlanNotFound)
+	ErrUnknownBranch           = Unprocessable("unknown_branch", BranchNotFound)
+	ErrUnknownMealPlan         = Unprocessable("unknown_meal_plan", MealPlanNotFound)
+	ErrTranslationNotFound     = NotFound("translation_not_found", TranslationNotFound)
+	ErrInvalidLocale           = BadRequest("invalid_locale", "Invalid locale")
+	ErrDefaultLocale           = BadRequest("default_locale", "The default locale is the base content and has no translation")
+	ErrInvalidTimezone         = BadRequest("invalid_timezone", "Invalid timezone")
+
+	ErrWebhookNotFound         = NotFound("webhook_not_found", WebhookNotFound)
+	ErrWebhookDeliveryNotFound = NotFound("webhook_delivery_not_found", WebhookDeliveryNotFound)
+)
+
+// Validation turns validator errors into a validation Error with one
+// FieldError per failed rule. Other errors are wrapped as they are.
+func Validation(err error) *Error {
+	var fieldErrs validator.ValidationErrors
+	if !errors.As(err, &fieldErrs) {
+		return ErrValidation.Wrap(err)
+	}
+
+	res := &Error{Kind: KindValidation, Code: ErrValidation.Code, Message: ErrValidation.Message}
+	for _, fe := range fieldErrs {
+		res.Fields = append(res.Fields, FieldError{
+			Field:   fe.Field(),
+			Rule:    fe.Tag(),
+			Param:   fe.Param(),
+			Message: ruleMessage(fe.Field(), fe.Tag(), fe.Param()),
+		})
+	}
+
+	return res
+}
+
+// InvalidField returns a validation Error for a single field
+func InvalidField(field string, rule string, message string) *Error {
+	return &Error{
+		Kind:    KindValidation,
+		Code:    ErrValidation.Code,
+		Message: ErrValidation.Message,
+		Fields:  []FieldError{{Field: field, Rule: rule, Message: message}},
+	}
+}
+
+// InvalidRule reports a field failing a validation rule checked outside the
+// validator, with the message the validator would have given
+func InvalidRule(field string, rule string, param string) *Error {
+	return &Error{
+		Kind:    KindValidation,
+		Code:    ErrValidation.Code,
+		Message: ErrValidation.Message,
+		Fields:  []FieldError{{Field: field, Rule: rule, Param: param, Message: ruleMessage(field, rule, param)}},
+	}
+}
+
+func ruleMessage(field string, rule string, param string) string {
+	switch rule {
+	case "required":
+		return field + " is required"
+	case "min", "gte":
+		return fmt.Sprintf("%s must be at least %s", field, param)
+	case "max", "lte":
+		return fmt.Sprintf("%s must be at most %s", field, param)
+	case "gt":
+		return fmt.Sprintf("%s must be greater than %s", field, param)
+	case "lt":
+		return fmt.Sprintf("%s must be less than %s", field, param)
+	case "oneof":
+		return fmt.Sprintf("%s must be one of [%s]", field, param)
+	case "sort_order":
+		return fmt.Sprintf("%s must be one of [%s], optionally followed by asc or desc", field, param)
+	case "gtfield":
+		return fmt.Sprintf("%s must be after %s", field, param)
+	case "clock":
+		return field + " must be a time of day such as 18:30"
+	case "timezone":
+		return field + " must be an IANA timezone such as Asia/Jakarta"
+	case "weekday":
+		return field + " must be the name of a weekday such as Monday"
+	case "outbound_url":
+		return field + " must be an https URL of a public host"
+	}
+
+	return fmt.Sprintf("%s failed on the '%s' rule", field, rule)
+}
+
+// NewValidator returns a validator reporting fields by their JSON name
+func NewValidator() *validator.Validate {
+	validate := validator.New()
+	validate.RegisterTagNameFunc(func(f reflect.StructField) string {
+		name := strings.SplitN(f.Tag.Get("json"), ",", 2)[0]
+		if name == "-" || name == "" {
+			return f.Name
+		}
+		return name
+	})
+	validate.RegisterValidation("clock", func(fl validator.FieldLevel) bool {
+		_, ok := ParseClock(fl.Field().String())
+		return ok
+	})
+	validate.RegisterValidation("weekday", func(fl validator.FieldLevel) bool {
+		_, ok := ParseWeekday(fl.Field().String())
+		return ok
+	})
+	validate.RegisterValidation("timezone", func(fl validator.FieldLevel) bool {
+		_, err := LoadTimezone(fl.Field().String())
+		return err == nil
+	})
+
+	return validate
+}
+
+const (
+	pgUniqueViolation     = "23505"
+	pgForeignKeyViolation = "23503"
+	pgQueryCanceled       = "57014"
+)
+
+// constraintErrors maps database constraints to the error shown to clients
+var constraintErrors = map[string]*Error{
+	"branches_branch_name_key":                 ErrBranchExists,
+	"branch_meal_plans_pkey":                   ErrBranchMealPlanExists,
+	"branch_meal_plans_branch_id_fkey":         ErrUnknownBranch,
+	"branch_meal_plans_meal_plan_id_fkey":      ErrUnknownMealPlan,
+	"branch_locations_branch_id_fkey":          ErrUnknownBranch,
+	"branch_translations_branch_id_fkey":       ErrBranchNotFound,
+	"meal_plan_translations_meal_plan_id_fkey": ErrMealPlanNotFound,
+}
+
+// TranslateDBError turns unique and foreign key violations into Conflict and
+// Unprocessable errors, other errors are returned unchanged.
+func TranslateDBError(err error) error {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+
+	if res, ok := constraintErrors[pqErr.Constraint]; ok {
+		return res
+	}
+
+	switch pqErr.Code {
+	case pgUniqueViolation:
+		return Conflict("conflict", "Resource already exists")
+	case pgForeignKeyViolation:
+		return Unprocessable("unknown_reference", "Referenced resource does not exist")
+	}
+
+	return err
+}
+
+// IsTimeout reports whether err comes from a deadline or cancellation, either
+// of the context itself or of a statement Postgres cancelled because of it.
+func IsTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return true
+	}
+
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == pgQueryCanceled
+}
@@ -0,0 +1,90 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	HeaderETag        = "ETag"
+	HeaderIfMatch     = "If-Match"
+	HeaderIfNoneMatch = "If-None-Match"
+)
+
+// ETag returns the entity tag of body, the representation of a resource at
+// version. The version is what writes are conditioned on. The digest of the
+// body tells apart representations of one version, such as the same branch
+// in another language or with other meal plans linked.
+func ETag(version uint64, body interface{}) string {
+	tag := strconv.FormatUint(version, 10)
+
+	if b, err := json.Marshal(body); err == nil {
+		sum := sha256.Sum256(b)
+		tag += "-" + hex.EncodeToString(sum[:8])
+	}
+
+	return strconv.Quote(tag)
+}
+
+// SetETag sends the entity tag of body, the resource at version
+func SetETag(c echo.Context, version uint64, body interface{}) {
+	c.Response().Header().Set(HeaderETag, ETag(version, body))
+}
+
+// IfMatch returns the version a write is conditioned on. It fails when the
+// If-Match header is missing, and returns 0, matching any version, for "*".
+// A tag that is not a version can never match.
+func IfMatch(c echo.Context) (uint64, error) {
+	header := strings.TrimSpace(c.Request().Header.Get(HeaderIfMatch))
+	if header == "" {
+		return 0, ErrPreconditionRequired
+	}
+
+	if header == "*" {
+		return 0, nil
+	}
+
+	tag, err := strconv.Unquote(header)
+	if err != nil {
+		return 0, ErrPreconditionFailed
+	}
+
+	// Only the version counts, whichever representation the tag was sent with
+	if i := strings.IndexByte(tag, '-'); i >= 0 {
+		tag = tag[:i]
+	}
+
+	version, err := strconv.ParseUint(tag, 10, 64)
+	if err != nil || version == 0 {
+		return 0, ErrPreconditionFailed
+	}
+
+	return version, nil
+}
+
+// NotModified sends the entity tag of body, the resource at version, and
+// reports whether the If-None-Match header already names it, in which case
+// the client's copy is current.
+func NotModified(c echo.Context, version uint64, body interface{}) bool {
+	etag := ETag(version, body)
+	c.Response().Header().Set(HeaderETag, etag)
+
+	header := c.Request().Header.Get(HeaderIfNoneMatch)
+	if header == "" {
+		return false
+	}
+
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestETag(t *testing.T) {
+	en := map[string]string{"branch_name": "Central"}
+	id := map[string]string{"branch_name": "Pusat"}
+
+	if ETag(3, en) == ETag(3, id) {
+		t.Error("representations of one version share a tag")
+	}
+	if ETag(3, en) != ETag(3, map[string]string{"branch_name": "Central"}) {
+		t.Error("one representation has different tags")
+	}
+}
+
+func TestIfMatch(t *testing.T) {
+	tests := []struct {
+		header  string
+		want    uint64
+		wantErr error
+	}{
+		{ETag(3, map[string]string{"branch_name": "Pusat"}), 3, nil},
+		{`"3"`, 3, nil},
+		{"*", 0, nil},
+		{"", 0, ErrPreconditionRequired},
+		{`"0-abc"`, 0, ErrPreconditionFailed},
+		{`"v3"`, 0, ErrPreconditionFailed},
+		{"3", 0, ErrPreconditionFailed},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPut, "/", nil)
+		req.Header.Set(HeaderIfMatch, tt.header)
+		c := echo.New().NewContext(req, httptest.NewRecorder())
+
+		got, err := IfMatch(c)
+		if got != tt.want || err != tt.wantErr {
+			t.Errorf("IfMatch(%s) = %d, %v, want %d, %v", tt.header, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package utils
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var kindCode = map[ErrorKind]codes.Code{
+	KindInternal:             codes.Internal,
+	KindBadRequest:           codes.InvalidArgument,
+	KindValidation:           codes.InvalidArgument,
+	KindUnauthorized:         codes.Unauthenticated,
+	KindForbidden:            codes.PermissionDenied,
+	KindNotFound:             codes.NotFound,
+	KindConflict:             codes.AlreadyExists,
+	KindUnprocessable:        codes.FailedPrecondition,
+	KindTimeout:              codes.DeadlineExceeded,
+	KindPreconditionFailed:   codes.Aborted,
+	KindPreconditionRequired: codes.FailedPrecondition,
+}
+
+var ErrUnauthenticated = Unauthorized("unauthenticated", "A valid API key is required")
+
+// GRPCStatus renders the error as a gRPC status, the counterpart of Status
+// for the HTTP API
+func (e *Error) GRPCStatus() *status.Status {
+	message := e.Message
+	if len(e.Fields) > 0 {
+		fields := make([]string, len(e.Fields))
+		for i, f := range e.Fields {
+			fields[i] = f.Message
+		}
+		message += ": " + strings.Join(fields, ", ")
+	}
+
+	return status.New(kindCode[e.Kind], message)
+}
+
+// GRPCError is the gRPC counterpart of HTTPErrorHandler. Domain errors keep
+// their code, anything else is logged and reported as internal without
+// leaking its text.
+func GRPCError(err error) error {
+	if IsTimeout(err) {
+		return ErrTimeout.GRPCStatus().Err()
+	}
+
+	var domainErr *Error
+	if errors.As(err, &domainErr) && domainErr.Kind != KindInternal {
+		return domainErr.GRPCStatus().Err()
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	logrus.WithField("component", "grpc").Error(err)
+
+	return ErrInternal.GRPCStatus().Err()
+}
+
+type grpcActorKey struct{}
+
+// GRPCActor returns who is making the call, the name of the API key it was
+// authenticated with.
+func GRPCActor(ctx context.Context) string {
+	if actor, ok := ctx.Value(grpcActorKey{}).(string); ok {
+		return actor
+	}
+
+	return AnonymousActor
+}
+
+// GRPCAuth authenticates calls by the bearer token in their authorization
+// metadata. keys maps each client's name to its API key. Health checks and
+// reflection are left open so load balancers and tooling can use them.
+type GRPCAuth struct {
+	keys map[string]string
+}
+
+// NewGRPCAuth parses keys given as name:key pairs
+func NewGRPCAuth(pairs []string) (*GRPCAuth, error) {
+	keys := make(map[string]string, len(pairs))
+
+	for _, pair := range pairs {
+		if pair == "" {
+			continue
+		}
+
+		name, key, ok := strings.Cut(pair, ":")
+		if !ok || name == "" || key == "" {
+			return nil, errors.New("grpc api keys must be name:key pairs")
+		}
+		keys[name] = key
+	}
+
+	return &GRPCAuth{keys: keys}, nil
+}
+
+func (a *GRPCAuth) authenticate(ctx context.Context, method string) (context.Context, error) {
+	if strings.HasPrefix(method, "/grpc.health.") || strings.HasPrefix(method, "/grpc.reflection.") {
+		return ctx, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	token := ""
+	if values := md.Get("authorization"); len(values) > 0 {
+		token = strings.TrimPrefix(values[0], "Bearer ")
+	}
+
+	for name, key := range a.keys {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(key)) == 1 {
+			return context.WithValue(ctx, grpcActorKey{}, name), nil
+		}
+	}
+
+	// Rejected calls never reach the logger, which runs after authentication
+	logrus.WithField("method", method).Warn("grpc call without a valid api key")
+
+	return nil, ErrUnauthenticated.GRPCStatus().Err()
+}
+
+func (a *GRPCAuth) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := a.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (a *GRPCAuth) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := a.authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &authedStream{ServerStream: ss, ctx: ctx})
+}
+
+type authedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authedStream) Context() context.Context {
+	return s.ctx
+}
+
+// GRPCUnaryLogger logs every unary call with its outcome and duration
+func GRPCUnaryLogger(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	res, err := handler(ctx, req)
+	logCall(ctx, info.FullMethod, start, err)
+
+	return res, err
+}
+
+// GRPCStreamLogger logs every streaming call with its outcome and duration
+func GRPCStreamLogger(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	logCall(ss.Context(), info.FullMethod, start, err)
+
+	return err
+}
+
+func logCall(ctx context.Context, method string, start time.Time, err error) {
+	entry := logrus.WithFields(logrus.Fields{
+		"method":   method,
+		"actor":    GRPCActor(ctx),
+		"code":     status.Code(err).String(),
+		"duration": time.Since(start),
+	})
+
+	if status.Code(err) == codes.Internal {
+		entry.Error(err)
+		return
+	}
+
+	entry.Info("grpc call")
+}
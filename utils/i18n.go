@@ -0,0 +1,238 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	HeaderAcceptLanguage  = "Accept-Language"
+	HeaderContentLanguage = "Content-Language"
+
+	// DefaultLocale is the language of the base content and of messages that
+	// have no translation
+	DefaultLocale = "en"
+)
+
+// messageLocales are the languages error and validation messages come in
+var messageLocales = []string{DefaultLocale, "id"}
+
+var localePattern = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8})*$`)
+
+// NormalizeLocale lowercases a language tag and uses - as its separator
+func NormalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
+
+// ValidLocale reports whether locale is a normalized language tag such as
+// "id" or "zh-hant-tw"
+func ValidLocale(locale string) bool {
+	return len(locale) <= 35 && localePattern.MatchString(locale)
+}
+
+// TranslationLocale normalizes the locale content is translated into
+func TranslationLocale(locale string) (string, error) {
+	locale = NormalizeLocale(locale)
+
+	if !ValidLocale(locale) {
+		return "", ErrInvalidLocale
+	}
+
+	if locale == DefaultLocale {
+		return "", ErrDefaultLocale
+	}
+
+	return locale, nil
+}
+
+// ParseAcceptLanguage returns the fallback chain of an Accept-Language
+// header: the languages in order of preference, each followed by its base
+// language unless the client lists it further down, ending with
+// DefaultLocale. "id-ID, ja;q=0.5" gives ["id-id", "id", "ja", "en"].
+func ParseAcceptLanguage(header string) []string {
+	type weighted struct {
+		locale string
+		q      float64
+	}
+
+	var prefs []weighted
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		locale := NormalizeLocale(fields[0])
+		if !ValidLocale(locale) {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if v, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = v
+				}
+			}
+		}
+		if q <= 0 {
+			continue
+		}
+
+		prefs = append(prefs, weighted{locale, q})
+	}
+
+	sort.SliceStable(prefs, func(i, j int) bool { return prefs[i].q > prefs[j].q })
+
+	var chain []string
+	seen := make(map[string]bool)
+	add := func(locale string) {
+		if !seen[locale] {
+			seen[locale] = true
+			chain = append(chain, locale)
+		}
+	}
+
+	listed := make(map[string]int)
+	for i, p := range prefs {
+		listed[p.locale] = i
+	}
+
+	for i, p := range prefs {
+		add(p.locale)
+
+		if dash := strings.Index(p.locale, "-"); dash > 0 {
+			base := p.locale[:dash]
+			if j, ok := listed[base]; !ok || j < i {
+				add(base)
+			}
+		}
+	}
+
+	add(DefaultLocale)
+
+	return chain
+}
+
+type localesKey struct{}
+
+// WithLocales returns a copy of ctx carrying the fallback chain of the request
+func WithLocales(ctx context.Context, locales []string) context.Context {
+	return context.WithValue(ctx, localesKey{}, locales)
+}
+
+// LocalesFrom returns the fallback chain carried by ctx, or nil when the
+// caller did not negotiate a language, in which case content is left as is.
+func LocalesFrom(ctx context.Context) []string {
+	locales, _ := ctx.Value(localesKey{}).([]string)
+	return locales
+}
+
+// Localize negotiates the language of the request from its Accept-Language
+// header and makes the fallback chain available to usecases through the
+// request context.
+func Localize(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		locales := ParseAcceptLanguage(c.Request().Header.Get(HeaderAcceptLanguage))
+
+		c.SetRequest(c.Request().WithContext(WithLocales(c.Request().Context(), locales)))
+		c.Response().Header().Add(echo.HeaderVary, HeaderAcceptLanguage)
+		c.Response().Header().Set(HeaderContentLanguage, messageLocale(locales))
+
+		return next(c)
+	}
+}
+
+// messageLocale picks the first language of the chain messages exist in
+func messageLocale(locales []string) string {
+	for _, locale := range locales {
+		for _, supported := range messageLocales {
+			if locale == supported {
+				return locale
+			}
+		}
+	}
+
+	return DefaultLocale
+}
+
+// messages translates error messages by error code
+var messages = map[string]map[string]string{
+	"id": {
+		"internal_error":              "Terjadi kesalahan yang tidak terduga",
+		"validation_failed":           "Validasi tidak valid",
+		"invalid_id":                  "ID tidak valid",
+		"invalid_body":                "Isi permintaan tidak valid",
+		"timeout":                     "Permintaan terlalu lama untuk diselesaikan",
+		"invalid_import_file":         "Berkas impor tidak dapat dibaca",
+		"precondition_failed":         "Data telah berubah sejak terakhir dibaca",
+		"precondition_required":       "Header If-Match wajib diisi",
+		"branch_not_found":            "Cabang tidak ditemukan",
+		"branch_exists":               "Nama cabang sudah ada",
+		"deleted_branch_not_found":    "Cabang yang dihapus tidak ditemukan",
+		"meal_plan_not_found":         "Paket makan tidak ditemukan",
+		"deleted_meal_plan_not_found": "Paket makan yang dihapus tidak ditemukan",
+		"branch_meal_plan_exists":     "Paket makan sudah tertaut ke cabang",
+		"branch_meal_plan_not_found":  "Paket makan tidak tertaut ke cabang",
+		"unknown_branch":              "Cabang tidak ditemukan",
+		"unknown_meal_plan":           "Paket makan tidak ditemukan",
+		"webhook_not_found":           "Webhook tidak ditemukan",
+		"webhook_delivery_not_found":  "Pengiriman webhook tidak ditemukan",
+		"translation_not_found":       "Terjemahan tidak ditemukan",
+		"invalid_locale":              "Lokal tidak valid",
+		"default_locale":              "Lokal bawaan adalah konten dasar dan tidak memiliki terjemahan",
+		"invalid_timezone":            "Zona waktu tidak valid",
+		"conflict":                    "Data sudah ada",
+		"unknown_reference":           "Data yang dirujuk tidak ada",
+	},
+}
+
+// ruleMessages translates the messages of validation rules, keyed by rule.
+// Each takes the field and the rule's parameter.
+var ruleMessages = map[string]map[string]string{
+	"id": {
+		"required":     "%[1]s wajib diisi",
+		"min":          "%[1]s minimal %[2]s",
+		"gte":          "%[1]s minimal %[2]s",
+		"max":          "%[1]s maksimal %[2]s",
+		"lte":          "%[1]s maksimal %[2]s",
+		"gt":           "%[1]s harus lebih dari %[2]s",
+		"lt":           "%[1]s harus kurang dari %[2]s",
+		"oneof":        "%[1]s harus salah satu dari [%[2]s]",
+		"sort_order":   "%[1]s harus salah satu dari [%[2]s], boleh diikuti asc atau desc",
+		"gtfield":      "%[1]s harus setelah %[2]s",
+		"clock":        "%[1]s harus berupa jam seperti 18:30",
+		"timezone":     "%[1]s harus berupa zona waktu IANA seperti Asia/Jakarta",
+		"weekday":      "%[1]s harus berupa nama hari seperti Monday",
+		"outbound_url": "%[1]s harus berupa URL https dari host publik",
+	},
+}
+
+// localizeMessage returns the message of the error code in locale, or
+// fallback when it has no translation
+func localizeMessage(locale string, code string, fallback string) string {
+	if message, ok := messages[locale][code]; ok {
+		return message
+	}
+
+	return fallback
+}
+
+// localizeFields translates the field messages built from a validation rule.
+// Messages written for a specific field are kept as they are.
+func localizeFields(locale string, fields []FieldError) []FieldError {
+	res := make([]FieldError, len(fields))
+	for i, f := range fields {
+		res[i] = f
+
+		format, ok := ruleMessages[locale][f.Rule]
+		if ok && f.Message == ruleMessage(f.Field, f.Rule, f.Param) {
+			res[i].Message = fmt.Sprintf(format, f.Field, f.Param)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,143 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator"
+)
+
+// MergePatch applies a JSON merge patch (RFC 7396) to the struct v points to.
+// v is encoded to JSON, patched and decoded back into a zeroed v, so members
+// the patch sets to null end up as zero values. Members v does not have are
+// rejected. It returns the paths of the members the patch touched, such as
+// "locations.latitude".
+func MergePatch(v interface{}, patch []byte) ([]string, error) {
+	var p interface{}
+	if err := json.Unmarshal(patch, &p); err != nil {
+		return nil, ErrInvalidBody.Wrap(err)
+	}
+
+	members, ok := p.(map[string]interface{})
+	if !ok {
+		return nil, ErrInvalidBody.Wrap(errors.New("merge patch must be a JSON object"))
+	}
+
+	doc, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var target interface{}
+	if err := json.Unmarshal(doc, &target); err != nil {
+		return nil, err
+	}
+
+	merged, err := json.Marshal(mergePatch(target, members))
+	if err != nil {
+		return nil, err
+	}
+
+	rv := reflect.ValueOf(v).Elem()
+	rv.Set(reflect.Zero(rv.Type()))
+
+	dec := json.NewDecoder(bytes.NewReader(merged))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return nil, InvalidField(typeErr.Field, "type", typeErr.Field+" must be a "+typeErr.Type.String())
+		}
+		return nil, ErrInvalidBody.Wrap(err)
+	}
+
+	return touchedPaths("", members), nil
+}
+
+func mergePatch(target interface{}, patch interface{}) interface{} {
+	members, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	doc, ok := target.(map[string]interface{})
+	if !ok {
+		doc = make(map[string]interface{})
+	}
+
+	for name, value := range members {
+		if value == nil {
+			delete(doc, name)
+			continue
+		}
+		doc[name] = mergePatch(doc[name], value)
+	}
+
+	return doc
+}
+
+func touchedPaths(prefix string, members map[string]interface{}) []string {
+	var paths []string
+
+	for name, value := range members {
+		path := prefix + name
+		paths = append(paths, path)
+
+		if nested, ok := value.(map[string]interface{}); ok {
+			paths = append(paths, touchedPaths(path+".", nested)...)
+		}
+	}
+
+	return paths
+}
+
+// ValidatePatch validates s but only reports the fields a merge patch
+// touched, so a value stored before a rule existed does not block unrelated
+// changes. Fields are reported by their path, such as "locations.latitude".
+func ValidatePatch(s interface{}, touched []string) error {
+	err := NewValidator().Struct(s)
+	if err == nil {
+		return nil
+	}
+
+	var fieldErrs validator.ValidationErrors
+	if !errors.As(err, &fieldErrs) {
+		return ErrValidation.Wrap(err)
+	}
+
+	res := &Error{Kind: KindValidation, Code: ErrValidation.Code, Message: ErrValidation.Message}
+	for _, fe := range fieldErrs {
+		// Namespace starts with the struct's own name
+		path := fe.Namespace()[strings.Index(fe.Namespace(), ".")+1:]
+		if !isTouched(path, touched) {
+			continue
+		}
+
+		res.Fields = append(res.Fields, FieldError{
+			Field:   path,
+			Rule:    fe.Tag(),
+			Param:   fe.Param(),
+			Message: ruleMessage(path, fe.Tag(), fe.Param()),
+		})
+	}
+
+	if len(res.Fields) == 0 {
+		return nil
+	}
+
+	return res
+}
+
+// isTouched reports whether path or one of its parents was patched
+func isTouched(path string, touched []string) bool {
+	for _, t := range touched {
+		if path == t || strings.HasPrefix(path, t+".") {
+			return true
+		}
+	}
+
+	return false
+}
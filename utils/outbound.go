@@ -0,0 +1,122 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// ErrPrivateAddress is returned when an outbound request would connect to
+// an address that is not on the public internet
+var ErrPrivateAddress = errors.New("address is not public")
+
+// nonPublicNetworks are reserved ranges net.IP has no predicate for
+var nonPublicNetworks = []*net.IPNet{
+	mustCIDR("0.0.0.0/8"),     // "this" network
+	mustCIDR("100.64.0.0/10"), // carrier-grade NAT, home of some metadata services
+	mustCIDR("192.0.0.0/24"),  // IETF protocol assignments
+	mustCIDR("198.18.0.0/15"), // benchmarking
+	mustCIDR("64:ff9b::/96"),  // NAT64, which reaches any IPv4 address
+}
+
+func mustCIDR(s string) *net.IPNet {
+	_, n, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// PublicIP reports whether ip is a public unicast address, rather than a
+// loopback, private, link-local, unspecified, multicast or reserved one
+func PublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsMulticast() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() {
+		return false
+	}
+
+	for _, n := range nonPublicNetworks {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// OutboundURL parses a URL the server is going to call. It must be https,
+// or http when allowHTTP is set, and name a host.
+func OutboundURL(raw string, allowHTTP bool) (*url.URL, error) {
+	u, err := url.Parse(raw)
+	if err != nil || u.Hostname() == "" || u.User != nil {
+		return nil, InvalidRule("url", "outbound_url", "")
+	}
+
+	if u.Scheme != "https" && !(allowHTTP && u.Scheme == "http") {
+		return nil, InvalidRule("url", "outbound_url", "")
+	}
+
+	return u, nil
+}
+
+// CheckOutboundURL checks raw with OutboundURL and resolves its host, which
+// must only have public addresses. The addresses may change by the time the
+// URL is called, so calls must also go through an OutboundClient.
+func CheckOutboundURL(ctx context.Context, raw string, allowHTTP bool) error {
+	u, err := OutboundURL(raw, allowHTTP)
+	if err != nil {
+		return err
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, u.Hostname())
+	if err != nil || len(addrs) == 0 {
+		return InvalidRule("url", "outbound_url", "")
+	}
+
+	for _, addr := range addrs {
+		if !PublicIP(addr.IP) {
+			return InvalidRule("url", "outbound_url", "")
+		}
+	}
+
+	return nil
+}
+
+// OutboundClient returns an HTTP client for calling URLs users provided. It
+// refuses to connect to addresses that are not public, checked on the
+// address actually dialed so DNS rebinding cannot get around it, ignores
+// proxy settings and does not follow redirects.
+func OutboundClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network string, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+
+			if ip := net.ParseIP(host); ip == nil || !PublicIP(ip) {
+				return ErrPrivateAddress
+			}
+
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConns:        10,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"sort"
+	"strings"
+)
+
+// SortOrders returns the values an order parameter accepts for sorting by
+// columns, each column alone or followed by asc or desc, mapped to the SQL
+// ordering they stand for. Ties are broken by id so pages are stable.
+func SortOrders(columns ...string) map[string]string {
+	orders := make(map[string]string, 3*len(columns))
+	for _, column := range columns {
+		orders[column] = column + ", id"
+		orders[column+" asc"] = column + ", id"
+		orders[column+" desc"] = column + " desc, id"
+	}
+
+	return orders
+}
+
+// SortOrder looks order up in orders, ignoring case and extra spaces.
+// Orders it does not have are rejected rather than passed to the database.
+func SortOrder(order string, orders map[string]string) (string, error) {
+	if res, ok := orders[strings.Join(strings.Fields(strings.ToLower(order)), " ")]; ok {
+		return res, nil
+	}
+
+	var columns []string
+	for o := range orders {
+		if !strings.Contains(o, " ") {
+			columns = append(columns, o)
+		}
+	}
+	sort.Strings(columns)
+
+	return "", InvalidRule("order", "sort_order", strings.Join(columns, " "))
+}
+
+// SearchColumn looks column up in columns, the columns a search accepts
+// mapped to the SQL expression matched against
+func SearchColumn(column string, columns map[string]string) (string, error) {
+	if res, ok := columns[column]; ok {
+		return res, nil
+	}
+
+	var names []string
+	for c := range columns {
+		names = append(names, c)
+	}
+	sort.Strings(names)
+
+	return "", InvalidRule("column", "oneof", strings.Join(names, " "))
+}
@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSortOrder(t *testing.T) {
+	orders := SortOrders("created_at", "branch_name")
+
+	tests := []struct {
+		order    string
+		want     string
+		wantRule string
+	}{
+		{order: "created_at", want: "created_at, id"},
+		{order: "created_at desc", want: "created_at desc, id"},
+		{order: " Branch_Name   ASC ", want: "branch_name, id"},
+		{order: "price", wantRule: "sort_order"},
+		{order: "created_at desc; DROP TABLE branches", wantRule: "sort_order"},
+		{order: "(SELECT 1)", wantRule: "sort_order"},
+		{order: "", wantRule: "sort_order"},
+	}
+
+	for _, tt := range tests {
+		got, err := SortOrder(tt.order, orders)
+
+		if tt.wantRule != "" {
+			var e *Error
+			if !errors.As(err, &e) || e.Status() != 400 || e.Fields[0].Field != "order" || e.Fields[0].Rule != tt.wantRule {
+				t.Errorf("SortOrder(%q) err = %v, want order failing %s", tt.order, err, tt.wantRule)
+			}
+			continue
+		}
+
+		if err != nil || got != tt.want {
+			t.Errorf("SortOrder(%q) = %q, %v, want %q", tt.order, got, err, tt.want)
+		}
+	}
+}
+
+func TestSearchColumn(t *testing.T) {
+	columns := map[string]string{"meal_plan_name": "meal_plan_name", "price": "price::text"}
+
+	if got, err := SearchColumn("price", columns); err != nil || got != "price::text" {
+		t.Errorf("SearchColumn(price) = %q, %v", got, err)
+	}
+
+	var e *Error
+	_, err := SearchColumn("1=1 OR meal_plan_name", columns)
+	if !errors.As(err, &e) || e.Fields[0].Field != "column" || e.Fields[0].Param != "meal_plan_name price" {
+		t.Errorf("err = %v, want column failing oneof", err)
+	}
+}
@@ -20,6 +20,7 @@ func ConnectRedis(c *config.Configuration) (*redis.Client, error) {
 	_, err := Redis.Ping().Result()
 	if err != nil {
 		logrus.Error(err)
+		return Redis, err
 	}
 
 	logrus.Info("Connected with Redis.")
@@ -1,9 +1,11 @@
 package utils
 
 type ResponseJSON struct {
-	Code    int         `json:"code,omitempty"`
-	Result  interface{} `json:"result,omitempty"`
-	Message string      `json:"message,omitempty"`
-	Error   interface{} `json:"error,omitempty"`
-	Success bool        `json:"success,omitempty"`
+	Code      int         `json:"code,omitempty"`
+	Result    interface{} `json:"result,omitempty"`
+	Message   string      `json:"message,omitempty"`
+	Error     interface{} `json:"error,omitempty"`
+	ErrorCode string      `json:"error_code,omitempty"`
+	Details   interface{} `json:"details,omitempty"`
+	Success   bool        `json:"success,omitempty"`
 }
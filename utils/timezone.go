@@ -0,0 +1,208 @@
+package utils
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultTimezone is the timezone of branches that did not configure one,
+// which is where every branch was before timezones could be configured
+const DefaultTimezone = "Asia/Jakarta"
+
+var clockLayouts = []string{"15:04", "15:04:05"}
+
+// timezones caches loaded locations, sessions being resolved in them on
+// every read
+var timezones sync.Map
+
+// LoadTimezone loads an IANA timezone. Unlike time.LoadLocation it rejects
+// "" and "Local", which depend on the host rather than on the branch.
+func LoadTimezone(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, ErrInvalidTimezone
+	}
+
+	if loc, ok := timezones.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, ErrInvalidTimezone.Wrap(err)
+	}
+
+	timezones.Store(name, loc)
+
+	return loc, nil
+}
+
+// ParseClock parses a local time of day such as "18:30" or "18:30:00" and
+// returns how long after midnight it is on the wall clock
+func ParseClock(clock string) (time.Duration, bool) {
+	for _, layout := range clockLayouts {
+		if t, err := time.Parse(layout, clock); err == nil {
+			return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second, true
+		}
+	}
+
+	return 0, false
+}
+
+// CanonicalClock spells a local time of day the way sessions are stored,
+// such as "18:30:00", so stored clocks compare in the order they fall in a
+// day. Anything ParseClock rejects is returned as it is.
+func CanonicalClock(clock string) string {
+	d, ok := ParseClock(clock)
+	if !ok {
+		return clock
+	}
+
+	return time.Time{}.Add(d).Format("15:04:05")
+}
+
+// ParseWeekday parses the English name of a weekday, ignoring case and
+// surrounding spaces
+func ParseWeekday(day string) (time.Weekday, bool) {
+	day = strings.TrimSpace(day)
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(day, d.String()) {
+			return d, true
+		}
+	}
+
+	return 0, false
+}
+
+// NextDay returns the midnight in loc starting the next day falling on day,
+// today included
+func NextDay(day time.Weekday, loc *time.Location, now time.Time) time.Time {
+	now = now.In(loc)
+	offset := (int(day) - int(now.Weekday()) + 7) % 7
+
+	return time.Date(now.Year(), now.Month(), now.Day()+offset, 0, 0, 0, 0, loc)
+}
+
+// AtClock returns the instant the wall clock in the timezone of date reads
+// clock on date. The offset is the one in effect at that time of that day,
+// so 18:30 stays 18:30 on either side of a daylight saving time change.
+//
+// Wall clock times a change makes ambiguous or skips are resolved the same
+// way every time rather than left to time.Date:
+//   - a repeated time, such as 01:30 when New York falls back, is its first
+//     occurrence, still on daylight saving time;
+//   - a skipped time, such as 02:30 when New York springs forward, is moved
+//     forward by the length of the gap, to 03:30 daylight saving time.
+func AtClock(date time.Time, clock time.Duration) time.Time {
+	loc := date.Location()
+	wall := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC).Add(clock.Round(time.Second))
+
+	// The offsets either side of a change on that day. Zones change at most
+	// once within a day and a half of any time.
+	_, before := wall.Add(-36 * time.Hour).In(loc).Zone()
+	_, after := wall.Add(36 * time.Hour).In(loc).Zone()
+
+	// The larger offset gives the earlier instant
+	offsets := []int{before, after}
+	if after > before {
+		offsets = []int{after, before}
+	}
+
+	for _, offset := range offsets {
+		t := wall.Add(-time.Duration(offset) * time.Second).In(loc)
+		if sameWallClock(t, wall) {
+			return t
+		}
+	}
+
+	// Skipped: read with the offset from before the gap, the wall clock
+	// lands past it
+	return wall.Add(-time.Duration(before) * time.Second).In(loc)
+}
+
+func sameWallClock(t time.Time, wall time.Time) bool {
+	y, m, d := t.Date()
+	wy, wm, wd := wall.Date()
+
+	return y == wy && m == wm && d == wd && t.Hour() == wall.Hour() && t.Minute() == wall.Minute() && t.Second() == wall.Second()
+}
+
+// CheckSession checks a weekly session held on day from start to end,
+// given as local times of day, and returns the weekday it falls on. A
+// session ending at or before the time it starts runs overnight and ends on
+// the following day, so "22:00" to "02:00" lasts four hours. Start and end
+// must differ.
+func CheckSession(day string, start string, end string) (time.Weekday, error) {
+	weekday, ok := ParseWeekday(day)
+	if !ok {
+		return 0, InvalidRule("day", "weekday", "")
+	}
+
+	from, ok := ParseClock(start)
+	if !ok {
+		return 0, InvalidRule("start_time", "clock", "")
+	}
+
+	to, ok := ParseClock(end)
+	if !ok {
+		return 0, InvalidRule("end_time", "clock", "")
+	}
+
+	if from == to {
+		return 0, InvalidRule("end_time", "gtfield", "start_time")
+	}
+
+	return weekday, nil
+}
+
+// SessionOn resolves a session held from start to end, given as local times
+// of day, into the instants it starts and ends when held on the day of date
+// in loc. A start moved past a skipped hour can overtake the end, as 02:30
+// to 03:15 does on the day New York springs forward; the session is then
+// empty, ending when it starts.
+func SessionOn(date time.Time, start string, end string, loc *time.Location) (time.Time, time.Time, error) {
+	from, ok := ParseClock(start)
+	if !ok {
+		return time.Time{}, time.Time{}, InvalidRule("start_time", "clock", "")
+	}
+
+	to, ok := ParseClock(end)
+	if !ok {
+		return time.Time{}, time.Time{}, InvalidRule("end_time", "clock", "")
+	}
+
+	date = date.In(loc)
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc)
+	endDay := day
+	if to <= from {
+		endDay = day.AddDate(0, 0, 1)
+	}
+
+	startsAt, endsAt := AtClock(day, from), AtClock(endDay, to)
+	if endsAt.Before(startsAt) {
+		endsAt = startsAt
+	}
+
+	return startsAt, endsAt, nil
+}
+
+// NextSession resolves a weekly session, checked as by CheckSession, into
+// the instants of the one under way at now or else of the next one. The
+// offsets are those of that week, so sessions keep their local times across
+// daylight saving time changes.
+func NextSession(day string, start string, end string, loc *time.Location, now time.Time) (time.Time, time.Time, error) {
+	weekday, err := CheckSession(day, start, end)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	// An overnight session started yesterday may still be under way
+	date := NextDay(weekday, loc, now.In(loc).AddDate(0, 0, -1))
+
+	startsAt, endsAt, err := SessionOn(date, start, end, loc)
+	if err != nil || endsAt.After(now) {
+		return startsAt, endsAt, err
+	}
+
+	return SessionOn(date.AddDate(0, 0, 7), start, end, loc)
+}
@@ -0,0 +1,300 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// 2026 transitions in New York: clocks spring forward from 02:00 EST to
+// 03:00 EDT on Sunday March 8 and fall back from 02:00 EDT to 01:00 EST on
+// Sunday November 1.
+func newYork(t *testing.T) *time.Location {
+	t.Helper()
+
+	loc, err := LoadTimezone("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return loc
+}
+
+func TestParseClock(t *testing.T) {
+	tests := []struct {
+		clock string
+		want  time.Duration
+		ok    bool
+	}{
+		{"18:30", 18*time.Hour + 30*time.Minute, true},
+		{"00:00", 0, true},
+		{"23:59:59", 23*time.Hour + 59*time.Minute + 59*time.Second, true},
+		{"07:05:09", 7*time.Hour + 5*time.Minute + 9*time.Second, true},
+		{"24:00", 0, false},
+		{"18:60", 0, false},
+		{"1830", 0, false},
+		{"6pm", 0, false},
+		{"18:30 ", 0, false},
+		{"", 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseClock(tt.clock)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("ParseClock(%q) = %v, %v, want %v, %v", tt.clock, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestCanonicalClock(t *testing.T) {
+	tests := []struct {
+		clock string
+		want  string
+	}{
+		{"18:30", "18:30:00"},
+		{"07:05:09", "07:05:09"},
+		{"00:00", "00:00:00"},
+		{"6pm", "6pm"},
+	}
+
+	for _, tt := range tests {
+		if got := CanonicalClock(tt.clock); got != tt.want {
+			t.Errorf("CanonicalClock(%q) = %q, want %q", tt.clock, got, tt.want)
+		}
+	}
+}
+
+func TestParseWeekday(t *testing.T) {
+	tests := []struct {
+		day  string
+		want time.Weekday
+		ok   bool
+	}{
+		{"Sunday", time.Sunday, true},
+		{" friday ", time.Friday, true},
+		{"SATURDAY", time.Saturday, true},
+		{"Everyday", 0, false},
+		{"Fri", 0, false},
+		{"", 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseWeekday(tt.day)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("ParseWeekday(%q) = %v, %v, want %v, %v", tt.day, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestNextDay(t *testing.T) {
+	ny := newYork(t)
+	jakarta, err := LoadTimezone("Asia/Jakarta")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	friday := time.Date(2026, 3, 6, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		day  time.Weekday
+		loc  *time.Location
+		now  time.Time
+		want string
+	}{
+		{"today counts", time.Friday, ny, friday, "2026-03-06T00:00:00-05:00"},
+		{"later this week", time.Sunday, ny, friday, "2026-03-08T00:00:00-05:00"},
+		{"midnight after spring forward", time.Thursday, ny, friday, "2026-03-12T00:00:00-04:00"},
+		{"midnight after fall back", time.Monday, ny, time.Date(2026, 10, 30, 12, 0, 0, 0, time.UTC), "2026-11-02T00:00:00-05:00"},
+		{"weekday of the zone, not of UTC", time.Friday, jakarta, time.Date(2026, 3, 6, 23, 0, 0, 0, time.UTC), "2026-03-13T00:00:00+07:00"},
+		{"still the previous day in New York", time.Saturday, ny, time.Date(2026, 3, 7, 3, 0, 0, 0, time.UTC), "2026-03-07T00:00:00-05:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NextDay(tt.day, tt.loc, tt.now).Format(time.RFC3339); got != tt.want {
+				t.Errorf("NextDay(%s) = %s, want %s", tt.day, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAtClock(t *testing.T) {
+	ny := newYork(t)
+	springForward := time.Date(2026, 3, 8, 0, 0, 0, 0, ny)
+	fallBack := time.Date(2026, 11, 1, 0, 0, 0, 0, ny)
+
+	tests := []struct {
+		name  string
+		date  time.Time
+		clock string
+		want  string
+	}{
+		{"summer", time.Date(2026, 7, 1, 0, 0, 0, 0, ny), "12:00", "2026-07-01T12:00:00-04:00"},
+		{"other zone", time.Date(2026, 3, 8, 0, 0, 0, 0, time.FixedZone("WIB", 7*3600)), "18:30", "2026-03-08T18:30:00+07:00"},
+
+		{"before spring forward", springForward, "01:30", "2026-03-08T01:30:00-05:00"},
+		// 02:30 does not exist, it is moved forward by the hour skipped
+		{"skipped by spring forward", springForward, "02:30", "2026-03-08T03:30:00-04:00"},
+		{"start of the skipped hour", springForward, "02:00", "2026-03-08T03:00:00-04:00"},
+		{"after spring forward", springForward, "03:30", "2026-03-08T03:30:00-04:00"},
+		{"evening after spring forward", springForward, "18:30", "2026-03-08T18:30:00-04:00"},
+
+		{"before fall back", fallBack, "00:30", "2026-11-01T00:30:00-04:00"},
+		// 01:30 happens twice, the first occurrence on EDT is picked
+		{"repeated by fall back", fallBack, "01:30", "2026-11-01T01:30:00-04:00"},
+		{"after fall back", fallBack, "02:30", "2026-11-01T02:30:00-05:00"},
+		{"evening after fall back", fallBack, "18:30", "2026-11-01T18:30:00-05:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock, ok := ParseClock(tt.clock)
+			if !ok {
+				t.Fatalf("ParseClock(%q) failed", tt.clock)
+			}
+
+			if got := AtClock(tt.date, clock).Format(time.RFC3339); got != tt.want {
+				t.Errorf("AtClock(%s) = %s, want %s", tt.clock, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckSession(t *testing.T) {
+	tests := []struct {
+		name       string
+		day        string
+		start, end string
+		want       time.Weekday
+		wantField  string
+		wantRule   string
+	}{
+		{name: "evening", day: "friday", start: "18:30", end: "21:00", want: time.Friday},
+		{name: "overnight", day: "Saturday", start: "22:00", end: "02:00", want: time.Saturday},
+		{name: "not a weekday", day: "Everyday", start: "18:30", end: "21:00", wantField: "day", wantRule: "weekday"},
+		{name: "malformed start", day: "Sunday", start: "6pm", end: "21:00", wantField: "start_time", wantRule: "clock"},
+		{name: "malformed end", day: "Sunday", start: "18:00", end: "25:00", wantField: "end_time", wantRule: "clock"},
+		{name: "empty session", day: "Sunday", start: "18:00", end: "18:00:00", wantField: "end_time", wantRule: "gtfield"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CheckSession(tt.day, tt.start, tt.end)
+
+			if tt.wantRule != "" {
+				var e *Error
+				if !errors.As(err, &e) || len(e.Fields) != 1 || e.Fields[0].Field != tt.wantField || e.Fields[0].Rule != tt.wantRule {
+					t.Fatalf("err = %v, want %s failing %s", err, tt.wantField, tt.wantRule)
+				}
+				return
+			}
+
+			if err != nil || got != tt.want {
+				t.Errorf("CheckSession() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSessionOn(t *testing.T) {
+	ny := newYork(t)
+	springForward := time.Date(2026, 3, 8, 12, 0, 0, 0, ny)
+	fallBack := time.Date(2026, 11, 1, 12, 0, 0, 0, ny)
+
+	tests := []struct {
+		name       string
+		date       time.Time
+		start, end string
+		wantStart  string
+		wantEnd    string
+		wantLength time.Duration
+	}{
+		{"evening after spring forward", springForward, "18:30", "21:00",
+			"2026-03-08T18:30:00-04:00", "2026-03-08T21:00:00-04:00", 150 * time.Minute},
+		{"across spring forward", springForward, "01:00", "03:00",
+			"2026-03-08T01:00:00-05:00", "2026-03-08T03:00:00-04:00", time.Hour},
+		{"overnight into spring forward", time.Date(2026, 3, 7, 12, 0, 0, 0, ny), "22:00", "02:00",
+			"2026-03-07T22:00:00-05:00", "2026-03-08T03:00:00-04:00", 4 * time.Hour},
+		// 02:30 moves to 03:30, past the end: the session is empty that day
+		{"start skipped past end", springForward, "02:30", "03:15",
+			"2026-03-08T03:30:00-04:00", "2026-03-08T03:30:00-04:00", 0},
+
+		{"across fall back", fallBack, "01:30", "02:30",
+			"2026-11-01T01:30:00-04:00", "2026-11-01T02:30:00-05:00", 2 * time.Hour},
+		{"overnight into fall back", time.Date(2026, 10, 31, 12, 0, 0, 0, ny), "23:00", "01:30",
+			"2026-10-31T23:00:00-04:00", "2026-11-01T01:30:00-04:00", 150 * time.Minute},
+		{"overnight across fall back", time.Date(2026, 10, 31, 12, 0, 0, 0, ny), "22:00", "03:00",
+			"2026-10-31T22:00:00-04:00", "2026-11-01T03:00:00-05:00", 6 * time.Hour},
+
+		{"date read in loc", time.Date(2026, 3, 9, 2, 0, 0, 0, time.UTC), "18:30", "21:00",
+			"2026-03-08T18:30:00-04:00", "2026-03-08T21:00:00-04:00", 150 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, err := SessionOn(tt.date, tt.start, tt.end, ny)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := start.Format(time.RFC3339); got != tt.wantStart {
+				t.Errorf("start = %s, want %s", got, tt.wantStart)
+			}
+			if got := end.Format(time.RFC3339); got != tt.wantEnd {
+				t.Errorf("end = %s, want %s", got, tt.wantEnd)
+			}
+			if got := end.Sub(start); got != tt.wantLength {
+				t.Errorf("length = %v, want %v", got, tt.wantLength)
+			}
+		})
+	}
+}
+
+func TestNextSession(t *testing.T) {
+	ny := newYork(t)
+
+	tests := []struct {
+		name       string
+		day        string
+		start, end string
+		now        time.Time
+		wantStart  string
+		wantEnd    string
+	}{
+		{"later today", "Friday", "18:30", "21:00", time.Date(2026, 3, 6, 12, 0, 0, 0, ny),
+			"2026-03-06T18:30:00-05:00", "2026-03-06T21:00:00-05:00"},
+		{"under way", "Friday", "18:30", "21:00", time.Date(2026, 3, 6, 19, 0, 0, 0, ny),
+			"2026-03-06T18:30:00-05:00", "2026-03-06T21:00:00-05:00"},
+		// The following week is on daylight saving time, the session keeps
+		// its local times rather than the offset of the first one
+		{"over, next week after spring forward", "Friday", "18:30", "21:00", time.Date(2026, 3, 6, 21, 0, 0, 0, ny),
+			"2026-03-13T18:30:00-04:00", "2026-03-13T21:00:00-04:00"},
+		{"overnight still under way", "Saturday", "22:00", "02:00", time.Date(2026, 3, 8, 1, 0, 0, 0, ny),
+			"2026-03-07T22:00:00-05:00", "2026-03-08T03:00:00-04:00"},
+		{"overnight over", "Saturday", "22:00", "02:00", time.Date(2026, 3, 8, 4, 0, 0, 0, ny),
+			"2026-03-14T22:00:00-04:00", "2026-03-15T02:00:00-04:00"},
+		{"weeks after it was stored", "Sunday", "18:30", "21:00", time.Date(2026, 11, 20, 12, 0, 0, 0, ny),
+			"2026-11-22T18:30:00-05:00", "2026-11-22T21:00:00-05:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, err := NextSession(tt.day, tt.start, tt.end, ny, tt.now)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := start.Format(time.RFC3339); got != tt.wantStart {
+				t.Errorf("start = %s, want %s", got, tt.wantStart)
+			}
+			if got := end.Format(time.RFC3339); got != tt.wantEnd {
+				t.Errorf("end = %s, want %s", got, tt.wantEnd)
+			}
+		})
+	}
+
+	if _, _, err := NextSession("Everyday", "18:30", "21:00", ny, time.Now()); err == nil {
+		t.Error("a day that is not a weekday resolved")
+	}
+}
@@ -0,0 +1,20 @@
+package utils
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// RunEvery calls fn on every tick of interval, logging the returned error.
+// It blocks, so callers usually start it in its own goroutine.
+func RunEvery(name string, interval time.Duration, fn func() error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := fn(); err != nil {
+			logrus.WithField("worker", name).Error(err)
+		}
+	}
+}